@@ -0,0 +1,242 @@
+// +build ignore
+
+// import_spdx imports license templates, or texts when there is no template,
+// from a local copy of the SPDX license-list-data repository
+// (https://github.com/spdx/license-list-data). Only the licenses listed in
+// import_spdx.ids are imported, unless -ids or an empty -ids-file is given.
+// Licenses already provided by another template, or deprecated, are skipped.
+// GNU "-only" and "-or-later" licenses are imported once, under their family
+// identifier.
+// Run "go generate" afterwards to embed them.
+//
+// Usage:
+//
+//	go run import_spdx.go -data path/to/license-list-data [-ids MIT-0,Zlib] [-ids-file FILE]
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+var (
+	flagData    = flag.String("data", "", "license-list-data directory")
+	flagIds     = flag.String("ids", "", "comma-separated license identifiers to import, instead of -ids-file ones")
+	flagIdsFile = flag.String("ids-file", "import_spdx.ids",
+		"file listing the license identifiers to import, one per line (empty: all)")
+	flagOut = flag.String("out", ".", "assets directory")
+)
+
+// spdxLicense is a licenses.json record
+type spdxLicense struct {
+	ID          string `json:"licenseId"`
+	Name        string `json:"name"`
+	Reference   string `json:"reference"`
+	Deprecated  bool   `json:"isDeprecatedLicenseId"`
+	OSIApproved bool   `json:"isOsiApproved"`
+	FSFLibre    bool   `json:"isFsfLibre"`
+}
+
+type spdxLicenseList struct {
+	Version  string        `json:"licenseListVersion"`
+	Licenses []spdxLicense `json:"licenses"`
+}
+
+// assetName returns the template file name of supplied license identifier.
+// Asset variables are named after the file name up to the first dot, so it
+// must only contain identifier characters.
+func assetName(id string) string {
+	name := []rune{}
+	for _, r := range strings.ToLower(id) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			name = append(name, r)
+		} else {
+			name = append(name, '_')
+		}
+	}
+	return "spdx_" + string(name) + ".txt"
+}
+
+// spdxAliases returns the identifiers designating the same license text as
+// supplied one: the deprecated identifier of GNU licenses and their "-only"
+// and "-or-later" variants.
+func spdxAliases(id string) []string {
+	base := strings.TrimSuffix(strings.TrimSuffix(id, "-only"), "-or-later")
+	if base == id {
+		return []string{id}
+	}
+	return []string{base, base + "+", base + "-only", base + "-or-later"}
+}
+
+// spdxFamily returns the identifier of supplied one without its "-only" or
+// "-or-later" suffix. GNU license texts do not tell these variants apart, so
+// their templates declare the family identifier.
+func spdxFamily(l spdxLicense) (id, name string) {
+	id = spdxAliases(l.ID)[0]
+	if id == l.ID {
+		return id, l.Name
+	}
+	name = strings.TrimSuffix(strings.TrimSuffix(l.Name, " only"), " or later")
+	return id, name
+}
+
+// knownIds returns the SPDX identifiers declared by the templates of supplied
+// directory, except imported ones, and their aliases.
+func knownIds(dir string) (map[string]bool, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.txt"))
+	if err != nil {
+		return nil, err
+	}
+	ids := map[string]bool{}
+	for _, path := range paths {
+		if strings.HasPrefix(filepath.Base(path), "spdx_") {
+			continue
+		}
+		f, err := os.Open(path)
+		if err != nil {
+			return nil, err
+		}
+		scanner := bufio.NewScanner(f)
+		for scanner.Scan() {
+			line := strings.TrimSpace(scanner.Text())
+			if strings.HasPrefix(line, "spdx-id:") {
+				for _, id := range spdxAliases(strings.TrimSpace(line[len("spdx-id:"):])) {
+					ids[id] = true
+				}
+				break
+			}
+		}
+		f.Close()
+		if err := scanner.Err(); err != nil {
+			return nil, err
+		}
+	}
+	return ids, nil
+}
+
+func writeTemplate(path string, l spdxLicense, text []byte) error {
+	id, name := spdxFamily(l)
+	w := &strings.Builder{}
+	fmt.Fprintf(w, "---\n")
+	fmt.Fprintf(w, "title: %s\n", name)
+	fmt.Fprintf(w, "spdx-id: %s\n", id)
+	fmt.Fprintf(w, "source: %s\n", l.Reference)
+	fmt.Fprintf(w, "osi-approved: %v\n", l.OSIApproved)
+	fmt.Fprintf(w, "fsf-libre: %v\n", l.FSFLibre)
+	fmt.Fprintf(w, "---\n\n")
+	w.Write(text)
+	if len(text) > 0 && text[len(text)-1] != '\n' {
+		w.WriteString("\n")
+	}
+	return ioutil.WriteFile(path, []byte(w.String()), 0644)
+}
+
+// writeGenerate writes the go:generate directives embedding the imported
+// templates.
+func writeGenerate(path string, names []string, version string, selected bool) error {
+	w := &strings.Builder{}
+	fmt.Fprintf(w, "// AUTOMATICALLY "+"GENERATED FILE. DO NOT EDIT.\n\n")
+	fmt.Fprintf(w, "// Templates imported from SPDX license list %s by import_spdx.go", version)
+	if selected {
+		fmt.Fprintf(w, ", for\n// the selected license identifiers")
+	}
+	fmt.Fprintf(w, ".\n\n")
+	fmt.Fprintf(w, "//go:generate -command asset go run asset.go\n")
+	for _, name := range names {
+		fmt.Fprintf(w, "//go:generate asset %s\n", name)
+	}
+	fmt.Fprintf(w, "\npackage assets\n")
+	return ioutil.WriteFile(path, []byte(w.String()), 0644)
+}
+
+func main() {
+	log.SetFlags(0)
+	flag.Parse()
+	if *flagData == "" {
+		log.Fatal("-data is required")
+	}
+	data, err := ioutil.ReadFile(filepath.Join(*flagData, "json", "licenses.json"))
+	if err != nil {
+		log.Fatal(err)
+	}
+	list := spdxLicenseList{}
+	if err := json.Unmarshal(data, &list); err != nil {
+		log.Fatalf("could not parse licenses.json: %s", err)
+	}
+	ids := strings.Split(*flagIds, ",")
+	if *flagIds == "" && *flagIdsFile != "" {
+		data, err := ioutil.ReadFile(*flagIdsFile)
+		if err != nil {
+			log.Fatal(err)
+		}
+		ids = strings.Split(string(data), "\n")
+	}
+	wanted := map[string]bool{}
+	for _, id := range ids {
+		if i := strings.Index(id, "#"); i >= 0 {
+			id = id[:i]
+		}
+		if id = strings.TrimSpace(id); id != "" {
+			wanted[id] = true
+		}
+	}
+	selected := len(wanted) > 0
+	known, err := knownIds(*flagOut)
+	if err != nil {
+		log.Fatal(err)
+	}
+	// Remove previous imports, the generate file lists all of them.
+	previous, err := filepath.Glob(filepath.Join(*flagOut, "spdx_*.txt*"))
+	if err != nil {
+		log.Fatal(err)
+	}
+	for _, path := range previous {
+		if err := os.Remove(path); err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	names := []string{}
+	for _, l := range list.Licenses {
+		id, _ := spdxFamily(l)
+		if l.Deprecated || known[id] || (selected && !wanted[l.ID]) {
+			continue
+		}
+		// Prefer templates, their variable and optional sections are
+		// understood by the matcher.
+		text, err := ioutil.ReadFile(filepath.Join(*flagData, "template", l.ID+".template.txt"))
+		if os.IsNotExist(err) {
+			text, err = ioutil.ReadFile(filepath.Join(*flagData, "text", l.ID+".txt"))
+		}
+		if err != nil {
+			log.Fatal(err)
+		}
+		name := assetName(id)
+		if err := writeTemplate(filepath.Join(*flagOut, name), l, text); err != nil {
+			log.Fatal(err)
+		}
+		names = append(names, name)
+		delete(wanted, l.ID)
+		// "-only" and "-or-later" variants share the same text
+		for _, id := range spdxAliases(l.ID) {
+			known[id] = true
+		}
+	}
+	for id := range wanted {
+		log.Printf("warning: %s is unknown, deprecated or already provided", id)
+	}
+	sort.Strings(names)
+	err = writeGenerate(filepath.Join(*flagOut, "spdx_assets.go"), names, list.Version, selected)
+	if err != nil {
+		log.Fatal(err)
+	}
+	log.Printf("imported %d licenses, run go generate to embed them", len(names))
+}
@@ -0,0 +1,7 @@
+// AUTOMATICALLY GENERATED FILE. DO NOT EDIT.
+
+package assets
+
+var spdx_afl_1_1 = txt(asset{Name: "spdx_afl_1_1.txt", Content: "" +
+	"---\ntitle: Academic Free License v1.1\nspdx-id: AFL-1.1\nsource: https://spdx.org/licenses/AFL-1.1.html\nosi-approved: true\nfsf-libre: false\n---\n\nAcademic Free License\n\nVersion 1.1\n\nThe Academic Free License applies to any original work of authorship (the\n\"Original Work\") whose owner (the \"Licensor\") has placed the following notice\nimmediately following the copyright notice for the Original Work:\n\n\"Licensed under the Academic Free License version 1.1.\"\n\nGrant of License. Licensor hereby grants to any person obtaining a copy of the\nOriginal Work (\"You\") a world-wide, royalty-free, non-exclusive, perpetual,\nnon-sublicenseable license\n\n(1) to use, copy, modify, merge, publish, perform, distribute and/or sell\ncopies of the Original Work and derivative works thereof, and\n\n(2) under patent claims owned or controlled by the Licensor that are embodied\nin the Original Work as furnished by the Licensor, to make, use, sell and\noffer for sale the Original Work and derivative works thereof, subject to the\nfollowing conditions.\n\nRight of Attribution. Redistributions of the Original Work must reproduce all\ncopyright notices in the Original Work as furnished by the Licensor, both in\nthe Original Work itself and in any documentation and/or other materials\nprovided with the distribution of the Original Work in executable form.\n\nExclusions from License Grant. Neither the names of Licensor, nor the names of\nany contributors to the Original Work, nor any of their trademarks or service\nmarks, may be used to endorse or promote products derived from this Original\nWork without express prior written permission of the Licensor.\n\nWARRANTY AND DISCLAIMERS. LICENSOR WARRANTS THAT THE COPYRIGHT IN AND TO THE\nORIGINAL WORK IS OWNED BY THE LICENSOR OR THAT THE ORIGINAL WORK IS\nDISTRIBUTED BY LICENSOR UNDER A VALID CURRENT LICENSE FROM THE COPYRIGHT\nOWNER. EXCEPT AS EXPRESSLY STATED IN THE IMMEDIATELY PRECEEDING SENTENCE, THE\nORIGINAL WORK IS PROVIDED UNDER THIS LICENSE ON AN \"AS IS\" BASIS, WITHOUT\nWARRANTY, EITHER EXPRESS OR IMPLIED, INCLUDING, WITHOUT LIMITATION, THE\nWARRANTY OF NON-INFRINGEMENT AND WARRANTIES THAT THE ORIGINAL WORK IS\nMERCHANTABLE OR FIT FOR A PARTICULAR PURPOSE. THE ENTIRE RISK AS TO THE\nQUALITY OF THE ORIGINAL WORK IS WITH YOU. THIS DISCLAIMER OF WARRANTY\nCONSTITUTES AN ESSENTIAL PART OF THIS LICENSE. NO LICENSE TO ORIGINAL WORK IS\nGRANTED HEREUNDER EXCEPT UNDER THIS DISCLAIMER.\n\nLIMITATION OF LIABILITY. UNDER NO CIRCUMSTANCES AND UNDER NO LEGAL THEORY,\nWHETHER TORT (INCLUDING NEGLIGENCE), CONTRACT, OR OTHERWISE, SHALL THE\nLICENSOR BE LIABLE TO ANY PERSON FOR ANY DIRECT, INDIRECT, SPECIAL,\nINCIDENTAL, OR CONSEQUENTIAL DAMAGES OF ANY CHARACTER ARISING AS A RESULT OF\nTHIS LICENSE OR THE USE OF THE ORIGINAL WORK INCLUDING, WITHOUT LIMITATION,\nDAMAGES FOR LOSS OF GOODWILL, WORK STOPPAGE, COMPUTER FAILURE OR MALFUNCTION,\nOR ANY AND ALL OTHER COMMERCIAL DAMAGES OR LOSSES, EVEN IF SUCH PERSON SHALL\nHAVE BEEN INFORMED OF THE POSSIBILITY OF SUCH DAMAGES. THIS LIMITATION OF\nLIABILITY SHALL NOT APPLY TO LIABILITY FOR DEATH OR PERSONAL INJURY RESULTING\nFROM SUCH PARTY&apos;S NEGLIGENCE TO THE EXTENT APPLICABLE LAW PROHIBITS SUCH\nLIMITATION. SOME JURISDICTIONS DO NOT ALLOW THE EXCLUSION OR LIMITATION OF\nINCIDENTAL OR CONSEQUENTIAL DAMAGES, SO THIS EXCLUSION AND LIMITATION MAY NOT\nAPPLY TO YOU.\n\nLicense to Source Code. The term \"Source Code\" means the preferred form of the\nOriginal Work for making modifications to it and all available documentation\ndescribing how to access and modify the Original Work. Licensor hereby agrees\nto provide a machine-readable copy of the Source Code of the Original Work\nalong with each copy of the Original Work that Licensor distributes. Licensor\nreserves the right to satisfy this obligation by placing a machine-readable\ncopy of the Source Code in an information repository reasonably calculated to\npermit inexpensive and convenient access by You for as long as Licensor\ncontinues to distribute the Original Work, and by publishing the address of\nthat information repository in a notice immediately following the copyright\nnotice that applies to the Original Work.\n\nMutual Termination for Patent Action. This License shall terminate\nautomatically and You may no longer exercise any of the rights granted to You\nby this License if You file a lawsuit in any court alleging that any OSI\nCertified open source software that is licensed under any license containing\nthis \"Mutual Termination for Patent Action\" clause infringes any patent claims\nthat are essential to use that software.\n\nThis license is Copyright (C) 2002 Lawrence E. Rosen. All rights reserved.\n\nPermission is hereby granted to copy and distribute this license without\nmodification. This license may not be modified without the express written\npermission of its copyright owner.\n\n" +
+	"", etag: `"xJ8J2cewvQo="`})
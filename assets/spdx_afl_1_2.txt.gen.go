@@ -0,0 +1,7 @@
+// AUTOMATICALLY GENERATED FILE. DO NOT EDIT.
+
+package assets
+
+var spdx_afl_1_2 = txt(asset{Name: "spdx_afl_1_2.txt", Content: "" +
+	"---\ntitle: Academic Free License v1.2\nspdx-id: AFL-1.2\nsource: https://spdx.org/licenses/AFL-1.2.html\nosi-approved: true\nfsf-libre: false\n---\n\nAcademic Free License\n\nVersion 1.2\n\nThis Academic Free License applies to any original work of authorship (the\n\"Original Work\") whose owner (the \"Licensor\") has placed the\n\nfollowing notice immediately following the copyright notice for the Original\nWork:\n\nLicensed under the Academic Free License version 1.2\n\nGrant of License. Licensor hereby grants to any person obtaining a copy of the\nOriginal Work (\"You\") a world-wide, royalty-free, non-exclusive, perpetual,\nnon-sublicenseable license (1) to use, copy, modify, merge, publish, perform,\ndistribute and/or sell copies of the Original Work and derivative works\nthereof, and (2) under patent claims owned or controlled by the Licensor that\nare embodied in the Original Work as furnished by the Licensor, to make, use,\nsell and offer for sale the Original Work and derivative works thereof,\nsubject to the\n\nfollowing conditions.\n\nAttribution Rights. You must retain, in the Source Code of any Derivative\nWorks that You create, all copyright, patent or trademark notices from the\nSource Code of the Original Work, as well as any notices of licensing and any\ndescriptive text identified therein as an \"Attribution Notice.\" You must cause\nthe Source Code for any Derivative Works that You create to carry a prominent\nAttribution Notice reasonably calculated to inform recipients that You have\nmodified the Original Work.\n\nExclusions from License Grant. Neither the names of Licensor, nor the names of\nany contributors to the Original Work, nor any of their trademarks or service\nmarks, may be used to endorse or promote products derived from this Original\nWork without express prior written permission of the Licensor.\n\nWarranty and Disclaimer of Warranty. Licensor warrants that the copyright in\nand to the Original Work is owned by the Licensor or that the Original Work is\ndistributed by Licensor under a valid current license from the copyright\nowner. Except as expressly stated in the immediately proceeding sentence, the\nOriginal Work is provided under this License on an \"AS IS\" BASIS and WITHOUT\nWARRANTY, either express or implied, including, without limitation, the\nwarranties of NON-INFRINGEMENT, MERCHANTABILITY or FITNESS FOR A PARTICULAR\nPURPOSE. THE ENTIRE RISK AS TO THE QUALITY OF THE ORIGINAL WORK IS WITH YOU.\nThis DISCLAIMER OF WARRANTY constitutes an essential part of this License. No\nlicense to Original Work is granted hereunder except under this disclaimer.\n\nLimitation of Liability. Under no circumstances and under no legal theory,\nwhether in tort (including negligence), contract, or otherwise, shall the\nLicensor be liable to any person for any direct, indirect, special,\nincidental, or consequential damages of any character arising as a result of\nthis License or the use of the Original Work including, without limitation,\ndamages for loss of goodwill, work stoppage, computer failure or malfunction,\nor any and all other commercial damages or losses. This limitation of\nliability shall not apply to liability for death or personal injury resulting\nfrom Licensor&apos;s negligence to the extent applicable law prohibits such\nlimitation. Some jurisdictions do not allow the exclusion or limitation of\nincidental or consequential damages, so this exclusion and limitation may not\napply to You.\n\nLicense to Source Code. The term \"Source Code\" means the preferred form of the\nOriginal Work for making modifications to it and all available\n\ndocumentation describing how to modify the Original Work. Licensor hereby\nagrees to provide a machine-readable copy of the Source Code of the Original\nWork along with each copy of the Original Work that Licensor distributes.\nLicensor reserves the right to satisfy this obligation by placing a machine-\nreadable copy of the Source Code in an information repository reasonably\ncalculated to permit inexpensive and convenient access by You for as long as\nLicensor continues to distribute the Original Work, and by publishing the\naddress of that information repository in a notice immediately following the\ncopyright notice that applies to the Original Work.\n\nMutual Termination for Patent Action. This License shall terminate\nautomatically and You may no longer exercise any of the rights granted to You\nby this License if You file a lawsuit in any court alleging that any OSI\nCertified open source software that is licensed under any license containing\nthis \"Mutual Termination for Patent Action\" clause infringes any patent claims\nthat are essential to use that software.\n\nRight to Use. You may use the Original Work in all ways not otherwise\nrestricted or conditioned by this License or by law, and Licensor promises not\nto interfere with or be responsible for such uses by You.\n\nThis license is Copyright (C) 2002 Lawrence E. Rosen. All rights reserved.\n\nPermission is hereby granted to copy and distribute this license without\nmodification. This license may not be modified without the express written\npermission of its copyright owner.\n\n" +
+	"", etag: `"Iv2gNy3GGbU="`})
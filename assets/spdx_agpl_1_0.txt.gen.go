@@ -0,0 +1,7 @@
+// AUTOMATICALLY GENERATED FILE. DO NOT EDIT.
+
+package assets
+
+var spdx_agpl_1_0 = txt(asset{Name: "spdx_agpl_1_0.txt", Content: "" +
+	"---\ntitle: Affero General Public License v1.0\nspdx-id: AGPL-1.0\nsource: https://spdx.org/licenses/AGPL-1.0-only.html\nosi-approved: false\nfsf-libre: false\n---\n\nAFFERO GENERAL PUBLIC LICENSE\nVersion 1, March 2002\n\nCopyright \u00a9 2002 Affero Inc.\n510 Third Street - Suite 225, San Francisco, CA 94107, USA\n\nThis license is a modified version of the GNU General Public License copyright\n(C) 1989, 1991 Free Software Foundation, Inc. made with their permission.\nSection 2(d) has been added to cover use of software over a computer network.\n\nEveryone is permitted to copy and distribute verbatim copies of this license\ndocument, but changing it is not allowed.\n\nPreamble\n\nThe licenses for most software are designed to take away your freedom to share\nand change it. By contrast, the Affero General Public License is intended to\nguarantee your freedom to share and change free software--to make sure the\nsoftware is free for all its users. This Public License applies to most of\nAffero's software and to any other program whose authors commit to using it.\n(Some other Affero software is covered by the GNU Library General Public License\ninstead.) You can apply it to your programs, too.\n\nWhen we speak of free software, we are referring to freedom, not price. This\nGeneral Public License is designed to make sure that you have the freedom to\ndistribute copies of free software (and charge for this service if you wish),\nthat you receive source code or can get it if you want it, that you can change\nthe software or use pieces of it in new free programs; and that you know you can\ndo these things.\n\nTo protect your rights, we need to make restrictions that forbid anyone to deny\nyou these rights or to ask you to surrender the rights. These restrictions\ntranslate to certain responsibilities for you if you distribute copies of the\nsoftware, or if you modify it.\n\nFor example, if you distribute copies of such a program, whether gratis or for a\nfee, you must give the recipients all the rights that you have. You must make\nsure that they, too, receive or can get the source code. And you must show them\nthese terms so they know their rights.\n\nWe protect your rights with two steps: (1) copyright the software, and (2) offer\nyou this license which gives you legal permission to copy, distribute and/or\nmodify the software.\n\nAlso, for each author's protection and ours, we want to make certain that\neveryone understands that there is no warranty for this free software. If the\nsoftware is modified by someone else and passed on, we want its recipients to\nknow that what they have is not the original, so that any problems introduced by\nothers will not reflect on the original authors' reputations.\n\nFinally, any free program is threatened constantly by software patents. We wish\nto avoid the danger that redistributors of a free program will individually\nobtain patent licenses, in effect making the program proprietary. To prevent\nthis, we have made it clear that any patent must be licensed for everyone's free\nuse or not licensed at all.\n\nThe precise terms and conditions for copying, distribution and modification\nfollow.\n\nTERMS AND CONDITIONS FOR COPYING, DISTRIBUTION AND MODIFICATION\n\n0. This License applies to any program or other work which contains a notice\n   placed by the copyright holder saying it may be distributed under the terms\n   of this Affero General Public License. The \"Program\", below, refers to any\n   such program or work, and a \"work based on the Program\" means either the\n   Program or any derivative work under copyright law: that is to say, a work\n   containing the Program or a portion of it, either verbatim or with\n   modifications and/or translated into another language. (Hereinafter,\n   translation is included without limitation in the term \"modification\".) Each\n   licensee is addressed as \"you\".\n\n   Activities other than copying, distribution and modification are not covered\n   by this License; they are outside its scope. The act of running the Program\n   is not restricted, and the output from the Program is covered only if its\n   contents constitute a work based on the Program (independent of having been\n   made by running the Program). Whether that is true depends on what the\n   Program does.\n\n1. You may copy and distribute verbatim copies of the Program's source code as\n   you receive it, in any medium, provided that you conspicuously and\n   appropriately publish on each copy an appropriate copyright notice and\n   disclaimer of warranty; keep intact all the notices that refer to this\n   License and to the absence of any warranty; and give any other recipients of\n   the Program a copy of this License along with the Program.\n\n   You may charge a fee for the physical act of transferring a copy, and you may\n   at your option offer warranty protection in exchange for a fee.\n\n2. You may modify your copy or copies of the Program or any portion of it, thus\n   forming a work based on the Program, and copy and distribute such\n   modifications or work under the terms of Section 1 above, provided that you\n   also meet all of these conditions:\n\n   a) You must cause the modified files to carry prominent notices stating\n      that you changed the files and the date of any change.\n\n   b) You must cause any work that you distribute or publish, that in whole or\n      in part contains or is derived from the Program or any part thereof, to be\n      licensed as a whole at no charge to all third parties under the terms of\n      this License.\n\n   c) If the modified program normally reads commands interactively when run, you\n      must cause it, when started running for such interactive use in the most\n      ordinary way, to print or display an announcement including an appropriate\n      copyright notice and a notice that there is no warranty (or else, saying\n      that you provide a warranty) and that users may redistribute the program\n      under these conditions, and telling the user how to view a copy of this\n      License.  (Exception: if the Program itself is interactive but does not\n      normally print such an announcement, your work based on the Program is not\n      required to print an announcement.)\n\n   d) If the Program as you received it is intended to interact with users\n      through a computer network and if, in the version you received, any user\n      interacting with the Program was given the opportunity to request\n      transmission to that user of the Program's complete source code, you must\n      not remove that facility from your modified version of the Program or work\n      based on the Program, and must offer an equivalent opportunity for all\n      users interacting with your Program through a computer network to request\n      immediate transmission by HTTP of the complete source code of your\n      modified version or other derivative work.\n\n   These requirements apply to the modified work as a whole. If identifiable\n   sections of that work are not derived from the Program, and can be reasonably\n   considered independent and separate works in themselves, then this License,\n   and its terms, do not apply to those sections when you distribute them as\n   separate works. But when you distribute the same sections as part of a whole\n   which is a work based on the Program, the distribution of the whole must be\n   on the terms of this License, whose permissions for other licensees extend to\n   the entire whole, and thus to each and every part regardless of who wrote it.\n\n   Thus, it is not the intent of this section to claim rights or contest your\n   rights to work written entirely by you; rather, the intent is to exercise the\n   right to control the distribution of derivative or collective works based on\n   the Program.\n\n   In addition, mere aggregation of another work not based on the Program with\n   the Program (or with a work based on the Program) on a volume of a storage or\n   distribution medium does not bring the other work under the scope of this\n   License.\n\n3. You may copy and distribute the Program (or a work based on it, under Section\n   2) in object code or executable form under the terms of Sections 1 and 2\n   above provided that you also do one of the following:\n\n   a) Accompany it with the complete corresponding machine-readable source code,\n      which must be distributed under the terms of Sections 1 and 2 above on a\n      medium customarily used for software interchange; or,\n\n   b) Accompany it with a written offer, valid for at least three years, to give\n      any third party, for a charge no more than your cost of physically\n      performing source distribution, a complete machine-readable copy of the\n      corresponding source code, to be distributed under the terms of Sections 1\n      and 2 above on a medium customarily used for software interchange; or,\n\n   c) Accompany it with the information you received as to the offer to\n      distribute corresponding source code. (This alternative is allowed only\n      for noncommercial distribution and only if you received the program in\n      object code or executable form with such an offer, in accord with\n      Subsection b above.)\n\n   The source code for a work means the preferred form of the work for making\n   modifications to it. For an executable work, complete source code means all\n   the source code for all modules it contains, plus any associated interface\n   definition files, plus the scripts used to control compilation and\n   installation of the executable. However, as a special exception, the source\n   code distributed need not include anything that is normally distributed (in\n   either source or binary form) with the major components (compiler, kernel,\n   and so on) of the operating system on which the executable runs, unless that\n   component itself accompanies the executable.\n\n   If distribution of executable or object code is made by offering access to\n   copy from a designated place, then offering equivalent access to copy the\n   source code from the same place counts as distribution of the source code,\n   even though third parties are not compelled to copy the source along with the\n   object code.\n\n4. You may not copy, modify, sublicense, or distribute the Program except as\n   expressly provided under this License. Any attempt otherwise to copy, modify,\n   sublicense or distribute the Program is void, and will automatically\n   terminate your rights under this License. However, parties who have received\n   copies, or rights, from you under this License will not have their licenses\n   terminated so long as such parties remain in full compliance.\n\n5. You are not required to accept this License, since you have not signed it.\n   However, nothing else grants you permission to modify or distribute the\n   Program or its derivative works. These actions are prohibited by law if you\n   do not accept this License. Therefore, by modifying or distributing the\n   Program (or any work based on the Program), you indicate your acceptance of\n   this License to do so, and all its terms and conditions for copying,\n   distributing or modifying the Program or works based on it.\n\n6. Each time you redistribute the Program (or any work based on the Program),\n   the recipient automatically receives a license from the original licensor to\n   copy, distribute or modify the Program subject to these terms and conditions.\n   You may not impose any further restrictions on the recipients' exercise of\n   the rights granted herein. You are not responsible for enforcing compliance\n   by third parties to this License.\n\n7. If, as a consequence of a court judgment or allegation of patent infringement\n   or for any other reason (not limited to patent issues), conditions are\n   imposed on you (whether by court order, agreement or otherwise) that\n   contradict the conditions of this License, they do not excuse you from the\n   conditions of this License. If you cannot distribute so as to satisfy\n   simultaneously your obligations under this License and any other pertinent\n   obligations, then as a consequence you may not distribute the Program at all.\n   For example, if a patent license would not permit royalty-free redistribution\n   of the Program by all those who receive copies directly or indirectly through\n   you, then the only way you could satisfy both it and this License would be to\n   refrain entirely from distribution of the Program.\n\n   If any portion of this section is held invalid or unenforceable under any\n   particular circumstance, the balance of the section is intended to apply and\n   the section as a whole is intended to apply in other circumstances.\n\n   It is not the purpose of this section to induce you to infringe any patents\n   or other property right claims or to contest validity of any such claims;\n   this section has the sole purpose of protecting the integrity of the free\n   software distribution system, which is implemented by public license\n   practices. Many people have made generous contributions to the wide range of\n   software distributed through that system in reliance on consistent\n   application of that system; it is up to the author/donor to decide if he or\n   she is willing to distribute software through any other system and a licensee\n   cannot impose that choice.\n\n   This section is intended to make thoroughly clear what is believed to be a\n   consequence of the rest of this License.\n\n8. If the distribution and/or use of the Program is restricted in certain\n   countries either by patents or by copyrighted interfaces, the original\n   copyright holder who places the Program under this License may add an\n   explicit geographical distribution limitation excluding those countries, so\n   that distribution is permitted only in or among countries not thus excluded.\n   In such case, this License incorporates the limitation as if written in the\n   body of this License.\n\n9. Affero Inc. may publish revised and/or new versions of the Affero General\n   Public License from time to time. Such new versions will be similar in spirit\n   to the present version, but may differ in detail to address new problems or\n   concerns.\n\n   Each version is given a distinguishing version number. If the Program\n   specifies a version number of this License which applies to it and \"any later\n   version\", you have the option of following the terms and conditions either of\n   that version or of any later version published by Affero, Inc. If the Program\n   does not specify a version number of this License, you may choose any version\n   ever published by Affero, Inc.\n\n   You may also choose to redistribute modified versions of this program under\n   any version of the Free Software Foundation's GNU General Public License\n   version 3 or higher, so long as that version of the GNU GPL includes terms\n   and conditions substantially equivalent to those of this license.\n\n10. If you wish to incorporate parts of the Program into other free programs\n    whose distribution conditions are different, write to the author to ask for\n    permission. For software which is copyrighted by Affero, Inc., write to us;\n    we sometimes make exceptions for this. Our decision will be guided by the\n    two goals of preserving the free status of all derivatives of our free\n    software and of promoting the sharing and reuse of software generally.\n\nNO WARRANTY\n\n11. BECAUSE THE PROGRAM IS LICENSED FREE OF CHARGE, THERE IS NO WARRANTY FOR THE\n    PROGRAM, TO THE EXTENT PERMITTED BY APPLICABLE LAW. EXCEPT WHEN OTHERWISE\n    STATED IN WRITING THE COPYRIGHT HOLDERS AND/OR OTHER PARTIES PROVIDE THE\n    PROGRAM \"AS IS\" WITHOUT WARRANTY OF ANY KIND, EITHER EXPRESSED OR IMPLIED,\n    INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND\n    FITNESS FOR A PARTICULAR PURPOSE. THE ENTIRE RISK AS TO THE QUALITY AND\n    PERFORMANCE OF THE PROGRAM IS WITH YOU. SHOULD THE PROGRAM PROVE DEFECTIVE,\n    YOU ASSUME THE COST OF ALL NECESSARY SERVICING, REPAIR OR CORRECTION.\n\n12. IN NO EVENT UNLESS REQUIRED BY APPLICABLE LAW OR AGREED TO IN WRITING WILL\n    ANY COPYRIGHT HOLDER, OR ANY OTHER PARTY WHO MAY MODIFY AND/OR REDISTRIBUTE\n    THE PROGRAM AS PERMITTED ABOVE, BE LIABLE TO YOU FOR DAMAGES, INCLUDING ANY\n    GENERAL, SPECIAL, INCIDENTAL OR CONSEQUENTIAL DAMAGES ARISING OUT OF THE USE\n    OR INABILITY TO USE THE PROGRAM (INCLUDING BUT NOT LIMITED TO LOSS OF DATA\n    OR DATA BEING RENDERED INACCURATE OR LOSSES SUSTAINED BY YOU OR THIRD\n    PARTIES OR A FAILURE OF THE PROGRAM TO OPERATE WITH ANY OTHER PROGRAMS),\n    EVEN IF SUCH HOLDER OR OTHER PARTY HAS BEEN ADVISED OF THE POSSIBILITY OF\n    SUCH DAMAGES.\n" +
+	"", etag: `"ZBizO+OpmOs="`})
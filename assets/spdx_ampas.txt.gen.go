@@ -0,0 +1,7 @@
+// AUTOMATICALLY GENERATED FILE. DO NOT EDIT.
+
+package assets
+
+var spdx_ampas = txt(asset{Name: "spdx_ampas.txt", Content: "" +
+	"---\ntitle: Academy of Motion Picture Arts and Sciences BSD\nspdx-id: AMPAS\nsource: https://spdx.org/licenses/AMPAS.html\nosi-approved: false\nfsf-libre: false\n---\n\nCopyright (c) 2006 Academy of Motion Picture Arts and Sciences\n(\"A.M.P.A.S.\"). Portions contributed by others as indicated.\nAll rights reserved.\n\nA world-wide, royalty-free, non-exclusive right to distribute, copy,\nmodify, create derivatives, and use, in source and binary forms, is\nhereby granted, subject to acceptance of this license. Performance of\nany of the aforementioned acts indicates acceptance to be bound by the\nfollowing terms and conditions:\n\n* Redistributions of source code must retain the above copyright\nnotice, this list of conditions and the Disclaimer of Warranty.\n\n* Redistributions in binary form must reproduce the above copyright\nnotice, this list of conditions and the Disclaimer of Warranty\nin the documentation and/or other materials provided with the\ndistribution.\n\n* Nothing in this license shall be deemed to grant any rights to\ntrademarks, copyrights, patents, trade secrets or any other\nintellectual property of A.M.P.A.S. or any contributors, except\nas expressly stated herein, and neither the name of A.M.P.A.S.\nnor of any other contributors to this software, may be used to\nendorse or promote products derived from this software without\nspecific prior written permission of A.M.P.A.S. or contributor,\nas appropriate.\n\nThis license shall be governed by the laws of the State of California,\nand subject to the jurisdiction of the courts therein.\n\nDisclaimer of Warranty: THIS SOFTWARE IS PROVIDED BY A.M.P.A.S. AND\nCONTRIBUTORS \"AS IS\" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING,\nBUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY, FITNESS\nFOR A PARTICULAR PURPOSE, AND NON-INFRINGEMENT ARE DISCLAIMED. IN NO\nEVENT SHALL A.M.P.A.S., ANY CONTRIBUTORS OR DISTRIBUTORS BE LIABLE FOR\nANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL\nDAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE\nGOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS\nINTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER\nIN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR\nOTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN\nIF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.\n" +
+	"", etag: `"iGjPl7WudjU="`})
@@ -0,0 +1,7 @@
+// AUTOMATICALLY GENERATED FILE. DO NOT EDIT.
+
+package assets
+
+var spdx_apache_1_1 = txt(asset{Name: "spdx_apache_1_1.txt", Content: "" +
+	"---\ntitle: Apache License 1.1\nspdx-id: Apache-1.1\nsource: https://spdx.org/licenses/Apache-1.1.html\nosi-approved: true\nfsf-libre: true\n---\n\n<<beginOptional>>Apache License 1.1<<endOptional>>\n\n<<var;name=\"copyright\";original=\"Copyright (c) 2000 The Apache Software Foundation. All rights reserved.\";match=\".{0,5000}\">>\n\nRedistribution and use in source and binary forms, with or without\nmodification, are permitted provided that the following conditions\nare met:\n\n1. Redistributions of source code must retain the above copyright\n   notice, this list of conditions and the following disclaimer.\n\n2. Redistributions in binary form must reproduce the above copyright\n   notice, this list of conditions and the following disclaimer in\n   the documentation and/or other materials provided with the\n   distribution.\n\n3. The end-user documentation included with the redistribution,\n   if any, must include the following acknowledgment:\n      \"This product includes software developed by the\n       Apache Software Foundation (http://www.apache.org/).\"\n   Alternately, this acknowledgment may appear in the software itself,\n   if and wherever such third-party acknowledgments normally appear.\n\n4. The names \"Apache\" and \"Apache Software Foundation\" must\n   not be used to endorse or promote products derived from this\n   software without prior written permission. For written\n   permission, please contact apache@apache.org.\n\n5. Products derived from this software may not be called \"Apache\",\n   nor may \"Apache\" appear in their name, without prior written\n   permission of the Apache Software Foundation.\n\nTHIS SOFTWARE IS PROVIDED ``AS IS'' AND ANY EXPRESSED OR IMPLIED\nWARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES\nOF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE\nDISCLAIMED. IN NO EVENT SHALL THE APACHE SOFTWARE FOUNDATION OR\nITS CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,\nSPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT\nLIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF\nUSE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND\nON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,\nOR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT\nOF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF\nSUCH DAMAGE.\n\n<<beginOptional>>This software consists of voluntary contributions made by many\nindividuals on behalf of the Apache Software Foundation. For more\ninformation on the Apache Software Foundation, please see\n<http://www.apache.org/>.\n\nPortions of this software are based upon public domain software\noriginally written at the National Center for Supercomputing Applications,\nUniversity of Illinois, Urbana-Champaign.<<endOptional>>\n" +
+	"", etag: `"FbZN1AvzEiw="`})
@@ -0,0 +1,7 @@
+// AUTOMATICALLY GENERATED FILE. DO NOT EDIT.
+
+package assets
+
+var spdx_apsl_1_2 = txt(asset{Name: "spdx_apsl_1_2.txt", Content: "" +
+	"---\ntitle: Apple Public Source License 1.2\nspdx-id: APSL-1.2\nsource: https://spdx.org/licenses/APSL-1.2.html\nosi-approved: true\nfsf-libre: false\n---\n\nApple Public Source License Ver. 1.2\n\n1. General; Definitions. This License applies to any program or other work which Apple Computer, Inc. (\"Apple\") makes publicly available and which contains a notice placed by Apple identifying such program or work as \"Original Code\" and stating that it is subject to the terms of this Apple Public Source License version 1.2 (or subsequent version thereof) (\"License\"). As used in this License: \n\n1.1 \"Applicable Patent Rights\" mean: (a) in the case where Apple is the\ngrantor of rights, (i) claims of patents that are now or hereafter acquired,\nowned by or assigned to Apple and (ii) that cover subject matter contained in\nthe Original Code, but only to the extent necessary to use, reproduce and/or\ndistribute the Original Code without infringement; and (b) in the case where\nYou are the grantor of rights, (i) claims of patents that are now or hereafter\nacquired, owned by or assigned to You and (ii) that cover subject matter in\nYour Modifications, taken alone or in combination with Original Code.\n\n1.2 \"Contributor\" means any person or entity that creates or contributes to\nthe creation of Modifications.\n\n1.3 \"Covered Code\" means the Original Code, Modifications, the combination of\nOriginal Code and any Modifications, and/or any respective portions thereof.\n\n1.4 \"Deploy\" means to use, sublicense or distribute Covered Code other than\nfor Your internal research and development (R&D) and/or Personal Use, and\nincludes without limitation, any and all internal use or distribution of\nCovered Code within Your business or organization except for R&D use and/or\nPersonal Use, as well as direct or indirect sublicensing or distribution of\nCovered Code by You to any third party in any form or manner.\n\n1.5 \"Larger Work\" means a work which combines Covered Code or portions thereof\nwith code not governed by the terms of this License.\n\n1.6 \"Modifications\" mean any addition to, deletion from, and/or change to, the\nsubstance and/or structure of the Original Code, any previous Modifications,\nthe combination of Original Code and any previous Modifications, and/or any\nrespective portions thereof. When code is released as a series of files, a\nModification is: (a) any addition to or deletion from the contents of a file\ncontaining Covered Code; and/or (b) any new file or other representation of\ncomputer program statements that contains any part of Covered Code.\n\n1.7 \"Original Code\" means (a) the Source Code of a program or other work as\noriginally made available by Apple under this License, including the Source\nCode of any updates or upgrades to such programs or works made available by\nApple under this License, and that has been expressly identified by Apple as\nsuch in the header file(s) of such work; and (b) the object code compiled from\nsuch Source Code and originally made available by Apple under this License.\n\n1.8 \"Personal Use\" means use of Covered Code by an individual solely for his\nor her personal, private and non-commercial purposes. An individual&apos;s use\nof Covered Code in his or her capacity as an officer, employee, member,\nindependent contractor or agent of a corporation, business or organization\n(commercial or non-commercial) does not qualify as Personal Use.\n\n1.9 \"Source Code\" means the human readable form of a program or other work\nthat is suitable for making modifications to it, including all modules it\ncontains, plus any associated interface definition files, scripts used to\ncontrol compilation and installation of an executable (object code).\n\n1.10 \"You\" or \"Your\" means an individual or a legal entity exercising rights\nunder this License. For legal entities, \"You\" or \"Your\" includes any entity\nwhich controls, is controlled by, or is under common control with, You, where\n\"control\" means (a) the power, direct or indirect, to cause the direction or\nmanagement of such entity, whether by contract or otherwise, or (b) ownership\nof fifty percent (50%) or more of the outstanding shares or beneficial\nownership of such entity.\n\n2. Permitted Uses; Conditions & Restrictions.Subject to the terms and conditions of this License, Apple hereby grants You, effective on the date You accept this License and download the Original Code, a world-wide, royalty-free, non-exclusive license, to the extent of Apple&apos;s Applicable Patent Rights and copyrights covering the Original Code, to do the following: \n\n2.1 You may use, reproduce, display, perform, modify and distribute Original\nCode, with or without Modifications, solely for Your internal research and\ndevelopment and/or Personal Use, provided that in each instance:\n\n(a) You must retain and reproduce in all copies of Original Code the copyright\nand other proprietary notices and disclaimers of Apple as they appear in the\nOriginal Code, and keep intact all notices in the Original Code that refer to\nthis License; and\n\n(b) You must include a copy of this License with every copy of Source Code of\nCovered Code and documentation You distribute, and You may not offer or impose\nany terms on such Source Code that alter or restrict this License or the\nrecipients&apos; rights hereunder, except as permitted under Section 6.\n\n2.2 You may use, reproduce, display, perform, modify and Deploy Covered Code,\nprovided that in each instance:\n\n(a) You must satisfy all the conditions of Section 2.1 with respect to the\nSource Code of the Covered Code;\n\n(b) You must duplicate, to the extent it does not already exist, the notice in\nExhibit A in each file of the Source Code of all Your Modifications, and cause\nthe modified files to carry prominent notices stating that You changed the\nfiles and the date of any change;\n\n(c) You must make Source Code of all Your Deployed Modifications publicly\navailable under the terms of this License, including the license grants set\nforth in Section 3 below, for as long as you Deploy the Covered Code or twelve\n(12) months from the date of initial Deployment, whichever is longer. You\nshould preferably distribute the Source Code of Your Deployed Modifications\nelectronically (e.g. download from a web site); and\n\n(d) if You Deploy Covered Code in object code, executable form only, You must\ninclude a prominent notice, in the code itself as well as in related\ndocumentation, stating that Source Code of the Covered Code is available under\nthe terms of this License with information on how and where to obtain such\nSource Code.\n\n2.3 You expressly acknowledge and agree that although Apple and each\nContributor grants the licenses to their respective portions of the Covered\nCode set forth herein, no assurances are provided by Apple or any Contributor\nthat the Covered Code does not infringe the patent or other intellectual\nproperty rights of any other entity. Apple and each Contributor disclaim any\nliability to You for claims brought by any other entity based on infringement\nof intellectual property rights or otherwise. As a condition to exercising the\nrights and licenses granted hereunder, You hereby assume sole responsibility\nto secure any other intellectual property rights needed, if any. For example,\nif a third party patent license is required to allow You to distribute the\nCovered Code, it is Your responsibility to acquire that license before\ndistributing the Covered Code.\n\n3. Your Grants. In consideration of, and as a condition to, the licenses granted to You under this License: \n\n(a) You hereby grant to Apple and all third parties a non-exclusive, royalty-\nfree license, under Your Applicable Patent Rights and other intellectual\nproperty rights (other than patent) owned or controlled by You, to use,\nreproduce, display, perform, modify, distribute and Deploy Your Modifications\nof the same scope and extent as Apple&apos;s licenses under Sections 2.1 and\n2.2; and\n\n(b) You hereby grant to Apple and its subsidiaries a non-exclusive, worldwide,\nroyalty-free, perpetual and irrevocable license, under Your Applicable Patent\nRights and other intellectual property rights (other than patent) owned or\ncontrolled by You, to use, reproduce, display, perform, modify or have\nmodified (for Apple and/or its subsidiaries), sublicense and distribute Your\nModifications, in any form, through multiple tiers of distribution.\n\n4. Larger Works. You may create a Larger Work by combining Covered Code with other code not governed by the terms of this License and distribute the Larger Work as a single product. In each such instance, You must make sure the requirements of this License are fulfilled for the Covered Code or any portion thereof. \n\n5. Limitations on Patent License. Except as expressly stated in Section 2, no other patent rights, express or implied, are granted by Apple herein. Modifications and/or Larger Works may require additional patent licenses from Apple which Apple may grant in its sole discretion. \n\n6. Additional Terms. You may choose to offer, and to charge a fee for, warranty, support, indemnity or liability obligations and/or other rights consistent with the scope of the license granted herein (\"Additional Terms\") to one or more recipients of Covered Code. However, You may do so only on Your own behalf and as Your sole responsibility, and not on behalf of Apple or any Contributor. You must obtain the recipient&apos;s agreement that any such Additional Terms are offered by You alone, and You hereby agree to indemnify, defend and hold Apple and every Contributor harmless for any liability incurred by or claims asserted against Apple or such Contributor by reason of any such Additional Terms. \n\n7. Versions of the License. Apple may publish revised and/or new versions of this License from time to time. Each version will be given a distinguishing version number. Once Original Code has been published under a particular version of this License, You may continue to use it under the terms of that version. You may also choose to use such Original Code under the terms of any subsequent version of this License published by Apple. No one other than Apple has the right to modify the terms applicable to Covered Code created under this License. \n\n8. NO WARRANTY OR SUPPORT. The Covered Code may contain in whole or in part pre-release, untested, or not fully tested works. The Covered Code may contain errors that could cause failures or loss of data, and may be incomplete or contain inaccuracies. You expressly acknowledge and agree that use of the Covered Code, or any portion thereof, is at Your sole and entire risk. THE COVERED CODE IS PROVIDED \"AS IS\" AND WITHOUT WARRANTY, UPGRADES OR SUPPORT OF ANY KIND AND APPLE AND APPLE&apos;S LICENSOR(S) (COLLECTIVELY REFERRED TO AS \"APPLE\" FOR THE PURPOSES OF SECTIONS 8 AND 9) AND ALL CONTRIBUTORS EXPRESSLY DISCLAIM ALL WARRANTIES AND/OR CONDITIONS, EXPRESS OR IMPLIED, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES AND/OR CONDITIONS OF MERCHANTABILITY, OF SATISFACTORY QUALITY, OF FITNESS FOR A PARTICULAR PURPOSE, OF ACCURACY, OF QUIET ENJOYMENT, AND NONINFRINGEMENT OF THIRD PARTY RIGHTS. APPLE AND EACH CONTRIBUTOR DOES NOT WARRANT AGAINST INTERFERENCE WITH YOUR ENJOYMENT OF THE COVERED CODE, THAT THE FUNCTIONS CONTAINED IN THE COVERED CODE WILL MEET YOUR REQUIREMENTS, THAT THE OPERATION OF THE COVERED CODE WILL BE UNINTERRUPTED OR ERROR-FREE, OR THAT DEFECTS IN THE COVERED CODE WILL BE CORRECTED. NO ORAL OR WRITTEN INFORMATION OR ADVICE GIVEN BY APPLE, AN APPLE AUTHORIZED REPRESENTATIVE OR ANY CONTRIBUTOR SHALL CREATE A WARRANTY. You acknowledge that the Covered Code is not intended for use in the operation of nuclear facilities, aircraft navigation, communication systems, or air traffic control machines in which case the failure of the Covered Code could lead to death, personal injury, or severe physical or environmental damage. \n\n9. LIMITATION OF LIABILITY. TO THE EXTENT NOT PROHIBITED BY LAW, IN NO EVENT SHALL APPLE OR ANY CONTRIBUTOR BE LIABLE FOR ANY INCIDENTAL, SPECIAL, INDIRECT OR CONSEQUENTIAL DAMAGES ARISING OUT OF OR RELATING TO THIS LICENSE OR YOUR USE OR INABILITY TO USE THE COVERED CODE, OR ANY PORTION THEREOF, WHETHER UNDER A THEORY OF CONTRACT, WARRANTY, TORT (INCLUDING NEGLIGENCE), PRODUCTS LIABILITY OR OTHERWISE, EVEN IF APPLE OR SUCH CONTRIBUTOR HAS BEEN ADVISED OF THE POSSIBILITY OF SUCH DAMAGES AND NOTWITHSTANDING THE FAILURE OF ESSENTIAL PURPOSE OF ANY REMEDY. SOME JURISDICTIONS DO NOT ALLOW THE LIMITATION OF LIABILITY OF INCIDENTAL OR CONSEQUENTIAL DAMAGES, SO THIS LIMITATION MAY NOT APPLY TO YOU. In no event shall Apple&apos;s total liability to You for all damages (other than as may be required by applicable law) under this License exceed the amount of fifty dollars ($50.00). \n\n10. Trademarks. This License does not grant any rights to use the trademarks or trade names \"Apple\", \"Apple Computer\", \"Mac OS X\", \"Mac OS X Server\", \"QuickTime\", \"QuickTime Streaming Server\" or any other trademarks or trade names belonging to Apple (collectively \"Apple Marks\") or to any trademark or trade name belonging to any Contributor. No Apple Marks may be used to endorse or promote products derived from the Original Code other than as permitted by and in strict compliance at all times with Apple&apos;s third party trademark usage guidelines which are posted at http://www.apple.com/legal/guidelinesfor3rdparties.html. \n\n11. Ownership. Subject to the licenses granted under this License, each Contributor retains all rights, title and interest in and to any Modifications made by such Contributor. Apple retains all rights, title and interest in and to the Original Code and any Modifications made by or on behalf of Apple (\"Apple Modifications\"), and such Apple Modifications will not be automatically subject to this License. Apple may, at its sole discretion, choose to license such Apple Modifications under this License, or on different terms from those contained in this License or may choose not to license them at all. \n\n12. Termination. \n\n12.1 Termination. This License and the rights granted hereunder will\nterminate:\n\n(a) automatically without notice from Apple if You fail to comply with any\nterm(s) of this License and fail to cure such breach within 30 days of\nbecoming aware of such breach;\n\n(b) immediately in the event of the circumstances described in Section\n13.5(b); or\n\n(c) automatically without notice from Apple if You, at any time during the\nterm of this License, commence an action for patent infringement against\nApple.\n\n12.2 Effect of Termination. Upon termination, You agree to immediately stop\nany further use, reproduction, modification, sublicensing and distribution of\nthe Covered Code and to destroy all copies of the Covered Code that are in\nyour possession or control. All sublicenses to the Covered Code which have\nbeen properly granted prior to termination shall survive any termination of\nthis License. Provisions which, by their nature, should remain in effect\nbeyond the termination of this License shall survive, including but not\nlimited to Sections 3, 5, 8, 9, 10, 11, 12.2 and 13. No party will be liable\nto any other for compensation, indemnity or damages of any sort solely as a\nresult of terminating this License in accordance with its terms, and\ntermination of this License will be without prejudice to any other right or\nremedy of any party.\n\n13. Miscellaneous. \n\n13.1 Government End Users. The Covered Code is a \"commercial item\" as defined\nin FAR 2.101. Government software and technical data rights in the Covered\nCode include only those rights customarily provided to the public as defined\nin this License. This customary commercial license in technical data and\nsoftware is provided in accordance with FAR 12.211 (Technical Data) and 12.212\n(Computer Software) and, for Department of Defense purchases, DFAR\n252.227-7015 (Technical Data -- Commercial Items) and 227.7202-3 (Rights in\nCommercial Computer Software or Computer Software Documentation). Accordingly,\nall U.S. Government End Users acquire Covered Code with only those rights set\nforth herein.\n\n13.2 Relationship of Parties. This License will not be construed as creating\nan agency, partnership, joint venture or any other form of legal association\nbetween or amongYou, Apple or any Contributor, and You will not represent to\nthe contrary, whether expressly, by implication, appearance or otherwise.\n\n13.3 Independent Development. Nothing in this License will impair Apple&apos;s\nright to acquire, license, develop, have others develop for it, market and/or\ndistribute technology or products that perform the same or similar functions\nas, or otherwise compete with, Modifications, Larger Works, technology or\nproducts that You may develop, produce, market or distribute.\n\n13.4 Waiver; Construction. Failure by Apple or any Contributor to enforce any\nprovision of this License will not be deemed a waiver of future enforcement of\nthat or any other provision. Any law or regulation which provides that the\nlanguage of a contract shall be construed against the drafter will not apply\nto this License.\n\n13.5 Severability. (a) If for any reason a court of competent jurisdiction\nfinds any provision of this License, or portion thereof, to be unenforceable,\nthat provision of the License will be enforced to the maximum extent\npermissible so as to effect the economic benefits and intent of the parties,\nand the remainder of this License will continue in full force and effect. (b)\nNotwithstanding the foregoing, if applicable law prohibits or restricts You\nfrom fully and/or specifically complying with Sections 2 and/or 3 or prevents\nthe enforceability of either of those Sections, this License will immediately\nterminate and You must immediately discontinue any use of the Covered Code and\ndestroy all copies of it that are in your possession or control.\n\n13.6 Dispute Resolution. Any litigation or other dispute resolution between\nYou and Apple relating to this License shall take place in the Northern\nDistrict of California, and You and Apple hereby consent to the personal\njurisdiction of, and venue in, the state and federal courts within that\nDistrict with respect to this License. The application of the United Nations\nConvention on Contracts for the International Sale of Goods is expressly\nexcluded.\n\n13.7 Entire Agreement; Governing Law. This License constitutes the entire\nagreement between the parties with respect to the subject matter hereof. This\nLicense shall be governed by the laws of the United States and the State of\nCalifornia, except that body of California law concerning conflicts of law.\n\nWhere You are located in the province of Quebec, Canada, the following clause\napplies: The parties hereby confirm that they have requested that this License\nand all related documents be drafted in English. Les parties ont exig\u00e9 que le\npr\u00e9sent contrat et tous les documents connexes soient r\u00e9dig\u00e9s en anglais.\n\nEXHIBIT A.\n\n\"Portions Copyright (c) 1999-2001 Apple Computer, Inc. All Rights Reserved.\n\nThis file contains Original Code and/or Modifications of Original Code as\ndefined in and that are subject to the Apple Public Source License Version 1.2\n(the &apos;License&apos;). You may not use this file except in compliance with\nthe License. Please obtain a copy of the License at\nhttp://www.apple.com/publicsource and read it before using this file.\n\nThe Original Code and all software distributed under the License are\ndistributed on an &apos;AS IS&apos; basis, WITHOUT WARRANTY OF ANY KIND,\nEITHER EXPRESS OR IMPLIED, AND APPLE HEREBY DISCLAIMS ALL SUCH WARRANTIES,\nINCLUDING WITHOUT LIMITATION, ANY WARRANTIES OF MERCHANTABILITY, FITNESS FOR A\nPARTICULAR PURPOSE, QUIET ENJOYMENT OR NON-INFRINGEMENT. Please see the\nLicense for the specific language governing rights and limitations under the\nLicense.\"\n\n" +
+	"", etag: `"/6JfB2AWIH0="`})
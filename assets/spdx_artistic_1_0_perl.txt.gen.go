@@ -0,0 +1,7 @@
+// AUTOMATICALLY GENERATED FILE. DO NOT EDIT.
+
+package assets
+
+var spdx_artistic_1_0_perl = txt(asset{Name: "spdx_artistic_1_0_perl.txt", Content: "" +
+	"---\ntitle: Artistic License 1.0 (Perl)\nspdx-id: Artistic-1.0-Perl\nsource: https://spdx.org/licenses/Artistic-1.0-Perl.html\nosi-approved: true\nfsf-libre: false\n---\n\nThe \"Artistic License\"\n\nPreamble\n\nThe intent of this document is to state the conditions under which a Package\nmay be copied, such that the Copyright Holder maintains some semblance of\nartistic control over the development of the package, while giving the users\nof the package the right to use and distribute the Package in a more-or-less\ncustomary fashion, plus the right to make reasonable modifications.\n\nDefinitions:\n\n\"Package\" refers to the collection of files distributed by the Copyright\nHolder, and derivatives of that collection of files created through textual\nmodification.\n\n\"Standard Version\" refers to such a Package if it has not been modified, or\nhas been modified in accordance with the wishes of the Copyright Holder as\nspecified below.\n\n\"Copyright Holder\" is whoever is named in the copyright or copyrights for the\npackage.\n\n\"You\" is you, if you&apos;re thinking about copying or distributing this\nPackage.\n\n\"Reasonable copying fee\" is whatever you can justify on the basis of media\ncost, duplication charges, time of people involved, and so on. (You will not\nbe required to justify it to the Copyright Holder, but only to the computing\ncommunity at large as a market that must bear the fee.)\n\n\"Freely Available\" means that no fee is charged for the item itself, though\nthere may be fees involved in handling the item. It also means that recipients\nof the item may redistribute it under the same conditions they received it.\n\n1. You may make and give away verbatim copies of the source form of the Standard Version of this Package without restriction, provided that you duplicate all of the original copyright notices and associated disclaimers. \n\n2. You may apply bug fixes, portability fixes and other modifications derived from the Public Domain or from the Copyright Holder. A Package modified in such a way shall still be considered the Standard Version. \n\n3. You may otherwise modify your copy of this Package in any way, provided that you insert a prominent notice in each changed file stating how and when you changed that file, and provided that you do at least ONE of the following: \n\na) place your modifications in the Public Domain or otherwise make them Freely\nAvailable, such as by posting said modifications to Usenet or an equivalent\nmedium, or placing the modifications on a major archive site such as\nuunet.uu.net, or by allowing the Copyright Holder to include your\nmodifications in the Standard Version of the Package.\n\nb) use the modified Package only within your corporation or organization.\n\nc) rename any non-standard executables so the names do not conflict with\nstandard executables, which must also be provided, and provide a separate\nmanual page for each non-standard executable that clearly documents how it\ndiffers from the Standard Version.\n\nd) make other distribution arrangements with the Copyright Holder.\n\n4. You may distribute the programs of this Package in object code or executable form, provided that you do at least ONE of the following: \n\na) distribute a Standard Version of the executables and library files,\ntogether with instructions (in the manual page or equivalent) on where to get\nthe Standard Version.\n\nb) accompany the distribution with the machine-readable source of the Package\nwith your modifications.\n\nc) give non-standard executables non-standard names, and clearly document the\ndifferences in manual pages (or equivalent), together with instructions on\nwhere to get the Standard Version.\n\nd) make other distribution arrangements with the Copyright Holder.\n\n5. You may charge a reasonable copying fee for any distribution of this Package. You may charge any fee you choose for support of this Package. You may not charge a fee for this Package itself. However, you may distribute this Package in aggregate with other (possibly commercial) programs as part of a larger (possibly commercial) software distribution provided that you do not advertise this Package as a product of your own. You may embed this Package&apos;s interpreter within an executable of yours (by linking); this shall be construed as a mere form of aggregation, provided that the complete Standard Version of the interpreter is so embedded. \n\n6. The scripts and library files supplied as input to or produced as output from the programs of this Package do not automatically fall under the copyright of this Package, but belong to whoever generated them, and may be sold commercially, and may be aggregated with this Package. If such scripts or library files are aggregated with this Package via the so-called \"undump\" or \"unexec\" methods of producing a binary executable image, then distribution of such an image shall neither be construed as a distribution of this Package nor shall it fall under the restrictions of Paragraphs 3 and 4, provided that you do not represent such an executable image as a Standard Version of this Package. \n\n7. C subroutines (or comparably compiled subroutines in other languages) supplied by you and linked into this Package in order to emulate subroutines and variables of the language defined by this Package shall not be considered part of this Package, but are the equivalent of input as in Paragraph 6, provided these subroutines do not change the language in any way that would cause it to fail the regression tests for the language. \n\n8. Aggregation of this Package with a commercial distribution is always permitted provided that the use of this Package is embedded; that is, when no overt attempt is made to make this Package&apos;s interfaces visible to the end user of the commercial distribution. Such use shall not be construed as a distribution of this Package. \n\n9. The name of the Copyright Holder may not be used to endorse or promote products derived from this software without specific prior written permission. \n\n10. THIS PACKAGE IS PROVIDED \"AS IS\" AND WITHOUT ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, WITHOUT LIMITATION, THE IMPLIED WARRANTIES OF MERCHANTIBILITY AND FITNESS FOR A PARTICULAR PURPOSE. \n\nThe End\n\n" +
+	"", etag: `"NMsouS2+H68="`})
@@ -0,0 +1,148 @@
+// AUTOMATICALLY GENERATED FILE. DO NOT EDIT.
+
+// Templates imported from SPDX license list 3.23 by import_spdx.go, for
+// the selected license identifiers.
+
+//go:generate -command asset go run asset.go
+//go:generate asset spdx_0bsd.txt
+//go:generate asset spdx_afl_1_1.txt
+//go:generate asset spdx_afl_1_2.txt
+//go:generate asset spdx_afl_2_0.txt
+//go:generate asset spdx_afl_2_1.txt
+//go:generate asset spdx_agpl_1_0.txt
+//go:generate asset spdx_aml.txt
+//go:generate asset spdx_ampas.txt
+//go:generate asset spdx_apache_1_0.txt
+//go:generate asset spdx_apache_1_1.txt
+//go:generate asset spdx_apsl_1_0.txt
+//go:generate asset spdx_apsl_1_1.txt
+//go:generate asset spdx_apsl_1_2.txt
+//go:generate asset spdx_apsl_2_0.txt
+//go:generate asset spdx_artistic_1_0.txt
+//go:generate asset spdx_artistic_1_0_cl8.txt
+//go:generate asset spdx_artistic_1_0_perl.txt
+//go:generate asset spdx_beerware.txt
+//go:generate asset spdx_bittorrent_1_1.txt
+//go:generate asset spdx_blessing.txt
+//go:generate asset spdx_bsd_2_clause_patent.txt
+//go:generate asset spdx_bsd_3_clause_attribution.txt
+//go:generate asset spdx_bsd_3_clause_lbnl.txt
+//go:generate asset spdx_bsd_4_clause.txt
+//go:generate asset spdx_bsd_4_clause_uc.txt
+//go:generate asset spdx_bsd_protection.txt
+//go:generate asset spdx_bsd_source_code.txt
+//go:generate asset spdx_bsl_1_0.txt
+//go:generate asset spdx_cal_1_0.txt
+//go:generate asset spdx_cc_by_1_0.txt
+//go:generate asset spdx_cc_by_2_0.txt
+//go:generate asset spdx_cc_by_2_5.txt
+//go:generate asset spdx_cc_by_3_0.txt
+//go:generate asset spdx_cc_by_4_0.txt
+//go:generate asset spdx_cc_by_nc_1_0.txt
+//go:generate asset spdx_cc_by_nc_2_0.txt
+//go:generate asset spdx_cc_by_nc_2_5.txt
+//go:generate asset spdx_cc_by_nc_3_0.txt
+//go:generate asset spdx_cc_by_nc_4_0.txt
+//go:generate asset spdx_cc_by_nc_nd_1_0.txt
+//go:generate asset spdx_cc_by_nc_nd_2_0.txt
+//go:generate asset spdx_cc_by_nc_nd_2_5.txt
+//go:generate asset spdx_cc_by_nc_nd_3_0.txt
+//go:generate asset spdx_cc_by_nc_nd_4_0.txt
+//go:generate asset spdx_cc_by_nc_sa_1_0.txt
+//go:generate asset spdx_cc_by_nc_sa_2_0.txt
+//go:generate asset spdx_cc_by_nc_sa_2_5.txt
+//go:generate asset spdx_cc_by_nc_sa_3_0.txt
+//go:generate asset spdx_cc_by_nc_sa_4_0.txt
+//go:generate asset spdx_cc_by_nd_1_0.txt
+//go:generate asset spdx_cc_by_nd_2_0.txt
+//go:generate asset spdx_cc_by_nd_2_5.txt
+//go:generate asset spdx_cc_by_nd_3_0.txt
+//go:generate asset spdx_cc_by_nd_4_0.txt
+//go:generate asset spdx_cc_by_sa_1_0.txt
+//go:generate asset spdx_cc_by_sa_2_0.txt
+//go:generate asset spdx_cc_by_sa_2_5.txt
+//go:generate asset spdx_cc_by_sa_3_0.txt
+//go:generate asset spdx_cc_by_sa_4_0.txt
+//go:generate asset spdx_cddl_1_0.txt
+//go:generate asset spdx_cddl_1_1.txt
+//go:generate asset spdx_cdla_permissive_1_0.txt
+//go:generate asset spdx_cecill_2_1.txt
+//go:generate asset spdx_clips.txt
+//go:generate asset spdx_cnri_python_gpl_compatible.txt
+//go:generate asset spdx_cpal_1_0.txt
+//go:generate asset spdx_cpl_1_0.txt
+//go:generate asset spdx_curl.txt
+//go:generate asset spdx_drl_1_0.txt
+//go:generate asset spdx_egenix.txt
+//go:generate asset spdx_elastic_2_0.txt
+//go:generate asset spdx_epl_2_0.txt
+//go:generate asset spdx_eupl_1_0.txt
+//go:generate asset spdx_eupl_1_1.txt
+//go:generate asset spdx_freeimage.txt
+//go:generate asset spdx_ftl.txt
+//go:generate asset spdx_gpl_1_0.txt
+//go:generate asset spdx_hdparm.txt
+//go:generate asset spdx_hpnd_sell_variant.txt
+//go:generate asset spdx_icu.txt
+//go:generate asset spdx_ijg.txt
+//go:generate asset spdx_imagemagick.txt
+//go:generate asset spdx_info_zip.txt
+//go:generate asset spdx_ipl_1_0.txt
+//go:generate asset spdx_json.txt
+//go:generate asset spdx_lgpl_2_0.txt
+//go:generate asset spdx_lgpllr.txt
+//go:generate asset spdx_libpng.txt
+//go:generate asset spdx_libtiff.txt
+//go:generate asset spdx_linux_openib.txt
+//go:generate asset spdx_lpl_1_0.txt
+//go:generate asset spdx_lpl_1_02.txt
+//go:generate asset spdx_lppl_1_3c.txt
+//go:generate asset spdx_mit_0.txt
+//go:generate asset spdx_mit_modern_variant.txt
+//go:generate asset spdx_mpl_1_0.txt
+//go:generate asset spdx_mpl_1_1.txt
+//go:generate asset spdx_naist_2003.txt
+//go:generate asset spdx_ncsa.txt
+//go:generate asset spdx_ngpl.txt
+//go:generate asset spdx_npl_1_0.txt
+//go:generate asset spdx_npl_1_1.txt
+//go:generate asset spdx_openssl.txt
+//go:generate asset spdx_openvision.txt
+//go:generate asset spdx_osl_1_0.txt
+//go:generate asset spdx_osl_1_1.txt
+//go:generate asset spdx_osl_2_0.txt
+//go:generate asset spdx_osl_2_1.txt
+//go:generate asset spdx_php_3_0.txt
+//go:generate asset spdx_php_3_01.txt
+//go:generate asset spdx_postgresql.txt
+//go:generate asset spdx_python_2_0.txt
+//go:generate asset spdx_qhull.txt
+//go:generate asset spdx_qpl_1_0.txt
+//go:generate asset spdx_ruby.txt
+//go:generate asset spdx_sgi_b_1_0.txt
+//go:generate asset spdx_sgi_b_1_1.txt
+//go:generate asset spdx_sgi_b_2_0.txt
+//go:generate asset spdx_sissl.txt
+//go:generate asset spdx_sissl_1_2.txt
+//go:generate asset spdx_sleepycat.txt
+//go:generate asset spdx_spencer_86.txt
+//go:generate asset spdx_sspl_1_0.txt
+//go:generate asset spdx_sunpro.txt
+//go:generate asset spdx_unicode_dfs_2015.txt
+//go:generate asset spdx_unicode_dfs_2016.txt
+//go:generate asset spdx_unicode_tou.txt
+//go:generate asset spdx_upl_1_0.txt
+//go:generate asset spdx_vim.txt
+//go:generate asset spdx_w3c.txt
+//go:generate asset spdx_w3c_19980720.txt
+//go:generate asset spdx_w3c_20150513.txt
+//go:generate asset spdx_x11.txt
+//go:generate asset spdx_xnet.txt
+//go:generate asset spdx_zend_2_0.txt
+//go:generate asset spdx_zlib.txt
+//go:generate asset spdx_zlib_acknowledgement.txt
+//go:generate asset spdx_zpl_1_1.txt
+//go:generate asset spdx_zpl_2_0.txt
+//go:generate asset spdx_zpl_2_1.txt
+
+package assets
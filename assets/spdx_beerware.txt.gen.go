@@ -0,0 +1,7 @@
+// AUTOMATICALLY GENERATED FILE. DO NOT EDIT.
+
+package assets
+
+var spdx_beerware = txt(asset{Name: "spdx_beerware.txt", Content: "" +
+	"---\ntitle: Beerware License\nspdx-id: Beerware\nsource: https://spdx.org/licenses/Beerware.html\nosi-approved: false\nfsf-libre: false\n---\n\n\"THE BEER-WARE LICENSE\" (Revision 42): <phk@FreeBSD.ORG> wrote this file. As\nlong as you retain this notice you can do whatever you want with this stuff.\nIf we meet some day, and you think this stuff is worth it, you can buy me a\nbeer in return Poul-Henning Kamp\n\n" +
+	"", etag: `"9GotVt4si8g="`})
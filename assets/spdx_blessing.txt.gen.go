@@ -0,0 +1,7 @@
+// AUTOMATICALLY GENERATED FILE. DO NOT EDIT.
+
+package assets
+
+var spdx_blessing = txt(asset{Name: "spdx_blessing.txt", Content: "" +
+	"---\ntitle: SQLite Blessing\nspdx-id: blessing\nsource: https://spdx.org/licenses/blessing.html\nosi-approved: false\nfsf-libre: false\n---\n\nThe author disclaims copyright to this source code. In place of a legal notice, here is a blessing:\n\nMay you do good and not evil.\n\nMay you find forgiveness for yourself and forgive others.\n\nMay you share freely, never taking more than you give.\n" +
+	"", etag: `"wP/vT6cJKCw="`})
@@ -0,0 +1,7 @@
+// AUTOMATICALLY GENERATED FILE. DO NOT EDIT.
+
+package assets
+
+var spdx_bsd_4_clause = txt(asset{Name: "spdx_bsd_4_clause.txt", Content: "" +
+	"---\ntitle: BSD 4-Clause \"Original\" or \"Old\" License\nspdx-id: BSD-4-Clause\nsource: https://spdx.org/licenses/BSD-4-Clause.html\nosi-approved: false\nfsf-libre: true\n---\n\n<<var;name=\"copyright\";original=\"Copyright (c) <year> <owner>. All rights reserved.\";match=\".{0,5000}\">>\n\nRedistribution and use in source and binary forms, with or without\nmodification, are permitted provided that the following conditions are met:\n\n1. Redistributions of source code must retain the above copyright notice,\n   this list of conditions and the following disclaimer.\n\n2. Redistributions in binary form must reproduce the above copyright notice,\n   this list of conditions and the following disclaimer in the documentation\n   and/or other materials provided with the distribution.\n\n3. All advertising materials mentioning features or use of this software must\n   display the following acknowledgement: This product includes software\n   developed by <<var;name=\"organization\";original=\"the organization\";match=\".+\">>.\n\n4. Neither the name of <<var;name=\"copyrightHolder\";original=\"the copyright holder\";match=\".+\">> nor the names of its contributors\n   may be used to endorse or promote products derived from this software\n   without specific prior written permission.\n\nTHIS SOFTWARE IS PROVIDED BY <<var;name=\"copyrightHolderAsIs\";original=\"COPYRIGHT HOLDER\";match=\".+\">> \"AS IS\" AND ANY EXPRESS OR\nIMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF\nMERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO\nEVENT SHALL <<var;name=\"copyrightHolderLiability\";original=\"COPYRIGHT HOLDER\";match=\".+\">> BE LIABLE FOR ANY DIRECT, INDIRECT,\nINCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT\nLIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA,\nOR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF\nLIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING\nNEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE,\nEVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.\n" +
+	"", etag: `"LgoviL7hJOs="`})
@@ -0,0 +1,7 @@
+// AUTOMATICALLY GENERATED FILE. DO NOT EDIT.
+
+package assets
+
+var spdx_bsd_protection = txt(asset{Name: "spdx_bsd_protection.txt", Content: "" +
+	"---\ntitle: BSD Protection License\nspdx-id: BSD-Protection\nsource: https://spdx.org/licenses/BSD-Protection.html\nosi-approved: false\nfsf-libre: false\n---\n\nBSD Protection License\n\nFebruary 2002\n\nPreamble\n\n-------- \n\nThe Berkeley Software Distribution (\"BSD\") license has proven very effective\nover the years at allowing for a wide spread of work throughout both\ncommercial and non-commercial products. For programmers whose primary\nintention is to improve the general quality of available software, it is\narguable that there is no better license than the BSD license, as it permits\nimprovements to be used wherever they will help, without idealogical or\nmetallic constraint.\n\nThis is of particular value to those who produce reference implementations of\nproposed standards: The case of TCP/IP clearly illustrates that freely and\nuniversally available implementations leads the rapid acceptance of standards\n-- often even being used instead of a de jure standard (eg, OSI network\nmodels).\n\nWith the rapid proliferation of software licensed under the GNU General Public\nLicense, however, the continued success of this role is called into question.\nGiven that the inclusion of a few lines of \"GPL-tainted\" work into a larger\nbody of work will result in restricted distribution -- and given that further\nwork will likely build upon the \"tainted\" portions, making them difficult to\nremove at a future date -- there are inevitable circumstances where authors\nwould, in order to protect their goal of providing for the widespread usage of\ntheir work, wish to guard against such \"GPL-taint\".\n\nIn addition, one can imagine that companies which operate by producing and\nselling (possibly closed-source) code would wish to protect themselves against\nthe rise of a GPL-licensed competitor. While under existing licenses this\nwould mean not releasing their code under any form of open license, if a\nlicense existed under which they could incorporate any improvements back into\ntheir own (commercial) products then they might be far more willing to provide\nfor non-closed distribution.\n\nFor the above reasons, we put forth this \"BSD Protection License\": A license\ndesigned to retain the freedom granted by the BSD license to use licensed\nworks in a wide variety of settings, both non-commercial and commercial, while\nprotecting the work from having future contributors restrict that freedom.\n\nThe precise terms and conditions for copying, distribution, and modification\nfollow.\n\nBSD PROTECTION LICENSE TERMS AND CONDITIONS FOR COPYING, DISTRIBUTION, AND\nMODIFICATION\n\n---------------------------------------------------------------- \n\n0. Definitions. \n\na) \"Program\", below, refers to any program or work distributed under the terms\nof this license.\n\nb) A \"work based on the Program\", below, refers to either the Program or any\nderivative work under copyright law.\n\nc) \"Modification\", below, refers to the act of creating derivative works.\n\nd) \"You\", below, refers to each licensee.\n\n1. Scope.   \nThis license governs the copying, distribution, and modification of the\nProgram. Other activities are outside the scope of this license; The act of\nrunning the Program is not restricted, and the output from the Program is\ncovered only if its contents constitute a work based on the Program.\n\n2. Verbatim copies.   \nYou may copy and distribute verbatim copies of the Program as you receive it,\nin any medium, provided that you conspicuously and appropriately publish on\neach copy an appropriate copyright notice; keep intact all the notices that\nrefer to this License and to the absence of any warranty; and give any other\nrecipients of the Program a copy of this License along with the Program.\n\n3. Modification and redistribution under closed license.   \nYou may modify your copy or copies of the Program, and distribute the\nresulting derivative works, provided that you meet the following conditions:\n\na) The copyright notice and disclaimer on the Program must be reproduced and\nincluded in the source code, documentation, and/or other materials provided in\na manner in which such notices are normally distributed.\n\nb) The derivative work must be clearly identified as such, in order that it\nmay not be confused with the original work.\n\nc) The license under which the derivative work is distributed must expressly\nprohibit the distribution of further derivative works.\n\n4. Modification and redistribution under open license.   \nYou may modify your copy or copies of the Program, and distribute the\nresulting derivative works, provided that you meet the following conditions:\n\na) The copyright notice and disclaimer on the Program must be reproduced and\nincluded in the source code, documentation, and/or other materials provided in\na manner in which such notices are normally distributed.\n\nb) You must clearly indicate the nature and date of any changes made to the\nProgram. The full details need not necessarily be included in the individual\nmodified files, provided that each modified file is clearly marked as such and\ninstructions are included on where the full details of the modifications may\nbe found.\n\nc) You must cause any work that you distribute or publish, that in whole or in\npart contains or is derived from the Program or any part thereof, to be\nlicensed as a whole at no charge to all third parties under the terms of this\nLicense.\n\n5. Implied acceptance.   \nYou may not copy or distribute the Program or any derivative works except as\nexpressly provided under this license. Consequently, any such action will be\ntaken as implied acceptance of the terms of this license.\n\n6. NO WARRANTY.   \nTHIS SOFTWARE IS PROVIDED \"AS IS\" AND ANY EXPRESS OR IMPLIED WARRANTIES,\nINCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND\nFITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE\nCOPYRIGHT HOLDER, OR ANY OTHER PARTY WHO MAY MODIFY AND/OR REDISTRIBUTE THE\nPROGRAM AS PERMITTED ABOVE, BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,\nSPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES ARISING OUT OF THE USE OR\nINABILITY TO USE THE PROGRAM (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF\nSUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS\nINTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN\nCONTRACT, STRICT LIABILITY, OR TORT, EVEN IF SUCH HOLDER OR OTHER PARTY HAS\nBEEN ADVISED OF THE POSSIBILITY OF SUCH DAMAGES.\n\n" +
+	"", etag: `"7cGC8p6R0K0="`})
@@ -0,0 +1,7 @@
+// AUTOMATICALLY GENERATED FILE. DO NOT EDIT.
+
+package assets
+
+var spdx_cc_by_2_0 = txt(asset{Name: "spdx_cc_by_2_0.txt", Content: "" +
+	"---\ntitle: Creative Commons Attribution 2.0 Generic\nspdx-id: CC-BY-2.0\nsource: https://spdx.org/licenses/CC-BY-2.0.html\nosi-approved: false\nfsf-libre: false\n---\n\nCreative Commons Attribution 2.0\n\nCREATIVE COMMONS CORPORATION IS NOT A LAW FIRM AND DOES NOT PROVIDE LEGAL\nSERVICES. DISTRIBUTION OF THIS LICENSE DOES NOT CREATE AN ATTORNEY-CLIENT\nRELATIONSHIP. CREATIVE COMMONS PROVIDES THIS INFORMATION ON AN \"AS-IS\" BASIS.\nCREATIVE COMMONS MAKES NO WARRANTIES REGARDING THE INFORMATION PROVIDED, AND\nDISCLAIMS LIABILITY FOR DAMAGES RESULTING FROM ITS USE.\n\nLicense\n\nTHE WORK (AS DEFINED BELOW) IS PROVIDED UNDER THE TERMS OF THIS CREATIVE\nCOMMONS PUBLIC LICENSE (\"CCPL\" OR \"LICENSE\"). THE WORK IS PROTECTED BY\nCOPYRIGHT AND/OR OTHER APPLICABLE LAW. ANY USE OF THE WORK OTHER THAN AS\nAUTHORIZED UNDER THIS LICENSE OR COPYRIGHT LAW IS PROHIBITED.\n\nBY EXERCISING ANY RIGHTS TO THE WORK PROVIDED HERE, YOU ACCEPT AND AGREE TO BE\nBOUND BY THE TERMS OF THIS LICENSE. THE LICENSOR GRANTS YOU THE RIGHTS\nCONTAINED HERE IN CONSIDERATION OF YOUR ACCEPTANCE OF SUCH TERMS AND\nCONDITIONS.\n\n1. Definitions\n\na. \"Collective Work\" means a work, such as a periodical issue, anthology or\nencyclopedia, in which the Work in its entirety in unmodified form, along with\na number of other contributions, constituting separate and independent works\nin themselves, are assembled into a collective whole. A work that constitutes\na Collective Work will not be considered a Derivative Work (as defined below)\nfor the purposes of this License.\n\nb. \"Derivative Work\" means a work based upon the Work or upon the Work and\nother pre-existing works, such as a translation, musical arrangement,\ndramatization, fictionalization, motion picture version, sound recording, art\nreproduction, abridgment, condensation, or any other form in which the Work\nmay be recast, transformed, or adapted, except that a work that constitutes a\nCollective Work will not be considered a Derivative Work for the purpose of\nthis License. For the avoidance of doubt, where the Work is a musical\ncomposition or sound recording, the synchronization of the Work in timed-\nrelation with a moving image (\"synching\") will be considered a Derivative Work\nfor the purpose of this License.\n\nc. \"Licensor\" means the individual or entity that offers the Work under the\nterms of this License.\n\nd. \"Original Author\" means the individual or entity who created the Work.\n\ne. \"Work\" means the copyrightable work of authorship offered under the terms\nof this License.\n\nf. \"You\" means an individual or entity exercising rights under this License\nwho has not previously violated the terms of this License with respect to the\nWork, or who has received express permission from the Licensor to exercise\nrights under this License despite a previous violation.\n\n2. Fair Use Rights. Nothing in this license is intended to reduce, limit, or restrict any rights arising from fair use, first sale or other limitations on the exclusive rights of the copyright owner under copyright law or other applicable laws.\n\n3. License Grant. Subject to the terms and conditions of this License, Licensor hereby grants You a worldwide, royalty-free, non-exclusive, perpetual (for the duration of the applicable copyright) license to exercise the rights in the Work as stated below:\n\na. to reproduce the Work, to incorporate the Work into one or more Collective\nWorks, and to reproduce the Work as incorporated in the Collective Works;\n\nb. to create and reproduce Derivative Works;\n\nc. to distribute copies or phonorecords of, display publicly, perform\npublicly, and perform publicly by means of a digital audio transmission the\nWork including as incorporated in Collective Works;\n\nd. to distribute copies or phonorecords of, display publicly, perform\npublicly, and perform publicly by means of a digital audio transmission\nDerivative Works.\n\ne. For the avoidance of doubt, where the work is a musical composition:\n\ni. Performance Royalties Under Blanket Licenses. Licensor waives the exclusive\nright to collect, whether individually or via a performance rights society\n(e.g. ASCAP, BMI, SESAC), royalties for the public performance or public\ndigital performance (e.g. webcast) of the Work.\n\nii. Mechanical Rights and Statutory Royalties. Licensor waives the exclusive\nright to collect, whether individually or via a music rights agency or\ndesignated agent (e.g. Harry Fox Agency), royalties for any phonorecord You\ncreate from the Work (\"cover version\") and distribute, subject to the\ncompulsory license created by 17 USC Section 115 of the US Copyright Act (or\nthe equivalent in other jurisdictions).\n\nf. Webcasting Rights and Statutory Royalties. For the avoidance of doubt,\nwhere the Work is a sound recording, Licensor waives the exclusive right to\ncollect, whether individually or via a performance-rights society (e.g.\nSoundExchange), royalties for the public digital performance (e.g. webcast) of\nthe Work, subject to the compulsory license created by 17 USC Section 114 of\nthe US Copyright Act (or the equivalent in other jurisdictions).\n\nThe above rights may be exercised in all media and formats whether now known\nor hereafter devised. The above rights include the right to make such\nmodifications as are technically necessary to exercise the rights in other\nmedia and formats. All rights not expressly granted by Licensor are hereby\nreserved.\n\n4. Restrictions. The license granted in Section 3 above is expressly made subject to and limited by the following restrictions:\n\na. You may distribute, publicly display, publicly perform, or publicly\ndigitally perform the Work only under the terms of this License, and You must\ninclude a copy of, or the Uniform Resource Identifier for, this License with\nevery copy or phonorecord of the Work You distribute, publicly display,\npublicly perform, or publicly digitally perform. You may not offer or impose\nany terms on the Work that alter or restrict the terms of this License or the\nrecipients&apos; exercise of the rights granted hereunder. You may not\nsublicense the Work. You must keep intact all notices that refer to this\nLicense and to the disclaimer of warranties. You may not distribute, publicly\ndisplay, publicly perform, or publicly digitally perform the Work with any\ntechnological measures that control access or use of the Work in a manner\ninconsistent with the terms of this License Agreement. The above applies to\nthe Work as incorporated in a Collective Work, but this does not require the\nCollective Work apart from the Work itself to be made subject to the terms of\nthis License. If You create a Collective Work, upon notice from any Licensor\nYou must, to the extent practicable, remove from the Collective Work any\nreference to such Licensor or the Original Author, as requested. If You create\na Derivative Work, upon notice from any Licensor You must, to the extent\npracticable, remove from the Derivative Work any reference to such Licensor or\nthe Original Author, as requested.\n\nb. If you distribute, publicly display, publicly perform, or publicly\ndigitally perform the Work or any Derivative Works or Collective Works, You\nmust keep intact all copyright notices for the Work and give the Original\nAuthor credit reasonable to the medium or means You are utilizing by conveying\nthe name (or pseudonym if applicable) of the Original Author if supplied; the\ntitle of the Work if supplied; to the extent reasonably practicable, the\nUniform Resource Identifier, if any, that Licensor specifies to be associated\nwith the Work, unless such URI does not refer to the copyright notice or\nlicensing information for the Work; and in the case of a Derivative Work, a\ncredit identifying the use of the Work in the Derivative Work (e.g., \"French\ntranslation of the Work by Original Author,\" or \"Screenplay based on original\nWork by Original Author\"). Such credit may be implemented in any reasonable\nmanner; provided, however, that in the case of a Derivative Work or Collective\nWork, at a minimum such credit will appear where any other comparable\nauthorship credit appears and in a manner at least as prominent as such other\ncomparable authorship credit.\n\n5. Representations, Warranties and Disclaimer\n\nUNLESS OTHERWISE MUTUALLY AGREED TO BY THE PARTIES IN WRITING, LICENSOR OFFERS\nTHE WORK AS-IS AND MAKES NO REPRESENTATIONS OR WARRANTIES OF ANY KIND\nCONCERNING THE WORK, EXPRESS, IMPLIED, STATUTORY OR OTHERWISE, INCLUDING,\nWITHOUT LIMITATION, WARRANTIES OF TITLE, MERCHANTIBILITY, FITNESS FOR A\nPARTICULAR PURPOSE, NONINFRINGEMENT, OR THE ABSENCE OF LATENT OR OTHER\nDEFECTS, ACCURACY, OR THE PRESENCE OF ABSENCE OF ERRORS, WHETHER OR NOT\nDISCOVERABLE. SOME JURISDICTIONS DO NOT ALLOW THE EXCLUSION OF IMPLIED\nWARRANTIES, SO SUCH EXCLUSION MAY NOT APPLY TO YOU.\n\n6. Limitation on Liability. EXCEPT TO THE EXTENT REQUIRED BY APPLICABLE LAW, IN NO EVENT WILL LICENSOR BE LIABLE TO YOU ON ANY LEGAL THEORY FOR ANY SPECIAL, INCIDENTAL, CONSEQUENTIAL, PUNITIVE OR EXEMPLARY DAMAGES ARISING OUT OF THIS LICENSE OR THE USE OF THE WORK, EVEN IF LICENSOR HAS BEEN ADVISED OF THE POSSIBILITY OF SUCH DAMAGES.\n\n7. Termination\n\na. This License and the rights granted hereunder will terminate automatically\nupon any breach by You of the terms of this License. Individuals or entities\nwho have received Derivative Works or Collective Works from You under this\nLicense, however, will not have their licenses terminated provided such\nindividuals or entities remain in full compliance with those licenses.\nSections 1, 2, 5, 6, 7, and 8 will survive any termination of this License.\n\nb. Subject to the above terms and conditions, the license granted here is\nperpetual (for the duration of the applicable copyright in the Work).\nNotwithstanding the above, Licensor reserves the right to release the Work\nunder different license terms or to stop distributing the Work at any time;\nprovided, however that any such election will not serve to withdraw this\nLicense (or any other license that has been, or is required to be, granted\nunder the terms of this License), and this License will continue in full force\nand effect unless terminated as stated above.\n\n8. Miscellaneous\n\na. Each time You distribute or publicly digitally perform the Work or a\nCollective Work, the Licensor offers to the recipient a license to the Work on\nthe same terms and conditions as the license granted to You under this\nLicense.\n\nb. Each time You distribute or publicly digitally perform a Derivative Work,\nLicensor offers to the recipient a license to the original Work on the same\nterms and conditions as the license granted to You under this License.\n\nc. If any provision of this License is invalid or unenforceable under\napplicable law, it shall not affect the validity or enforceability of the\nremainder of the terms of this License, and without further action by the\nparties to this agreement, such provision shall be reformed to the minimum\nextent necessary to make such provision valid and enforceable.\n\nd. No term or provision of this License shall be deemed waived and no breach\nconsented to unless such waiver or consent shall be in writing and signed by\nthe party to be charged with such waiver or consent.\n\ne. This License constitutes the entire agreement between the parties with\nrespect to the Work licensed here. There are no understandings, agreements or\nrepresentations with respect to the Work not specified here. Licensor shall\nnot be bound by any additional provisions that may appear in any communication\nfrom You. This License may not be modified without the mutual written\nagreement of the Licensor and You.\n\nCreative Commons is not a party to this License, and makes no warranty\nwhatsoever in connection with the Work. Creative Commons will not be liable to\nYou or any party on any legal theory for any damages whatsoever, including\nwithout limitation any general, special, incidental or consequential damages\narising in connection to this license. Notwithstanding the foregoing two (2)\nsentences, if Creative Commons has expressly identified itself as the Licensor\nhereunder, it shall have all rights and obligations of Licensor.\n\nExcept for the limited purpose of indicating to the public that the Work is\nlicensed under the CCPL, neither party will use the trademark \"Creative\nCommons\" or any related trademark or logo of Creative Commons without the\nprior written consent of Creative Commons. Any permitted use will be in\ncompliance with Creative Commons&apos; then-current trademark usage\nguidelines, as may be published on its website or otherwise made available\nupon request from time to time.\n\nCreative Commons may be contacted at http://creativecommons.org/.\n\n" +
+	"", etag: `"kAZPlrxH4sA="`})
@@ -0,0 +1,7 @@
+// AUTOMATICALLY GENERATED FILE. DO NOT EDIT.
+
+package assets
+
+var spdx_cddl_1_0 = txt(asset{Name: "spdx_cddl_1_0.txt", Content: "" +
+	"---\ntitle: Common Development and Distribution License 1.0\nspdx-id: CDDL-1.0\nsource: https://spdx.org/licenses/CDDL-1.0.html\nosi-approved: true\nfsf-libre: false\n---\n\nCOMMON DEVELOPMENT AND DISTRIBUTION LICENSE (CDDL)\n\nVersion 1.0\n\n1. Definitions. \n\n1.1. \u201cContributor\u201d means each individual or entity that creates or contributes\nto the creation of Modifications.\n\n1.2. \u201cContributor Version\u201d means the combination of the Original Software,\nprior Modifications used by a Contributor (if any), and the Modifications made\nby that particular Contributor.\n\n1.3. \u201cCovered Software\u201d means (a) the Original Software, or (b) Modifications,\nor (c) the combination of files containing Original Software with files\ncontaining Modifications, in each case including portions thereof.\n\n1.4. \u201cExecutable\u201d means the Covered Software in any form other than Source\nCode.\n\n1.5. \u201cInitial Developer\u201d means the individual or entity that first makes\nOriginal Software available under this License.\n\n1.6. \u201cLarger Work\u201d means a work which combines Covered Software or portions\nthereof with code not governed by the terms of this License.\n\n1.7. \u201cLicense\u201d means this document.\n\n1.8. \u201cLicensable\u201d means having the right to grant, to the maximum extent\npossible, whether at the time of the initial grant or subsequently acquired,\nany and all of the rights conveyed herein.\n\n1.9. \u201cModifications\u201d means the Source Code and Executable form of any of the\nfollowing:\n\nA. Any file that results from an addition to, deletion from or modification of\nthe contents of a file containing Original Software or previous Modifications;\n\nB. Any new file that contains any part of the Original Software or previous\nModification; or\n\nC. Any new file that is contributed or otherwise made available under the\nterms of this License.\n\n1.10. \u201cOriginal Software\u201d means the Source Code and Executable form of\ncomputer software code that is originally released under this License.\n\n1.11. \u201cPatent Claims\u201d means any patent claim(s), now owned or hereafter\nacquired, including without limitation, method, process, and apparatus claims,\nin any patent Licensable by grantor.\n\n1.12. \u201cSource Code\u201d means (a) the common form of computer software code in\nwhich modifications are made and (b) associated documentation included in or\nwith such code.\n\n1.13. \u201cYou\u201d (or \u201cYour\u201d) means an individual or a legal entity exercising\nrights under, and complying with all of the terms of, this License. For legal\nentities, \u201cYou\u201d includes any entity which controls, is controlled by, or is\nunder common control with You. For purposes of this definition, \u201ccontrol\u201d\nmeans (a) the power, direct or indirect, to cause the direction or management\nof such entity, whether by contract or otherwise, or (b) ownership of more\nthan fifty percent (50%) of the outstanding shares or beneficial ownership of\nsuch entity.\n\n2. License Grants. \n\n2.1. The Initial Developer Grant.\n\nConditioned upon Your compliance with Section 3.1 below and subject to third\nparty intellectual property claims, the Initial Developer hereby grants You a\nworld-wide, royalty-free, non-exclusive license:\n\n(a) under intellectual property rights (other than patent or trademark)\nLicensable by Initial Developer, to use, reproduce, modify, display, perform,\nsublicense and distribute the Original Software (or portions thereof), with or\nwithout Modifications, and/or as part of a Larger Work; and\n\n(b) under Patent Claims infringed by the making, using or selling of Original\nSoftware, to make, have made, use, practice, sell, and offer for sale, and/or\notherwise dispose of the Original Software (or portions thereof).\n\n(c) The licenses granted in Sections 2.1(a) and (b) are effective on the date\nInitial Developer first distributes or otherwise makes the Original Software\navailable to a third party under the terms of this License.\n\n(d) Notwithstanding Section 2.1(b) above, no patent license is granted: (1)\nfor code that You delete from the Original Software, or (2) for infringements\ncaused by: (i) the modification of the Original Software, or (ii) the\ncombination of the Original Software with other software or devices.\n\n2.2. Contributor Grant.\n\nConditioned upon Your compliance with Section 3.1 below and subject to third\nparty intellectual property claims, each Contributor hereby grants You a\nworld-wide, royalty-free, non-exclusive license:\n\n(a) under intellectual property rights (other than patent or trademark)\nLicensable by Contributor to use, reproduce, modify, display, perform,\nsublicense and distribute the Modifications created by such Contributor (or\nportions thereof), either on an unmodified basis, with other Modifications, as\nCovered Software and/or as part of a Larger Work; and\n\n(b) under Patent Claims infringed by the making, using, or selling of\nModifications made by that Contributor either alone and/or in combination with\nits Contributor Version (or portions of such combination), to make, use, sell,\noffer for sale, have made, and/or otherwise dispose of: (1) Modifications made\nby that Contributor (or portions thereof); and (2) the combination of\nModifications made by that Contributor with its Contributor Version (or\nportions of such combination).\n\n(c) The licenses granted in Sections 2.2(a) and 2.2(b) are effective on the\ndate Contributor first distributes or otherwise makes the Modifications\navailable to a third party.\n\n(d) Notwithstanding Section 2.2(b) above, no patent license is granted: (1)\nfor any code that Contributor has deleted from the Contributor Version; (2)\nfor infringements caused by: (i) third party modifications of Contributor\nVersion, or (ii) the combination of Modifications made by that Contributor\nwith other software (except as part of the Contributor Version) or other\ndevices; or (3) under Patent Claims infringed by Covered Software in the\nabsence of Modifications made by that Contributor.\n\n3. Distribution Obligations. \n\n3.1. Availability of Source Code.\n\nAny Covered Software that You distribute or otherwise make available in\nExecutable form must also be made available in Source Code form and that\nSource Code form must be distributed only under the terms of this License. You\nmust include a copy of this License with every copy of the Source Code form of\nthe Covered Software You distribute or otherwise make available. You must\ninform recipients of any such Covered Software in Executable form as to how\nthey can obtain such Covered Software in Source Code form in a reasonable\nmanner on or through a medium customarily used for software exchange.\n\n3.2. Modifications.\n\nThe Modifications that You create or to which You contribute are governed by\nthe terms of this License. You represent that You believe Your Modifications\nare Your original creation(s) and/or You have sufficient rights to grant the\nrights conveyed by this License.\n\n3.3. Required Notices.\n\nYou must include a notice in each of Your Modifications that identifies You as\nthe Contributor of the Modification. You may not remove or alter any\ncopyright, patent or trademark notices contained within the Covered Software,\nor any notices of licensing or any descriptive text giving attribution to any\nContributor or the Initial Developer.\n\n3.4. Application of Additional Terms.\n\nYou may not offer or impose any terms on any Covered Software in Source Code\nform that alters or restricts the applicable version of this License or the\nrecipients\u2019 rights hereunder. You may choose to offer, and to charge a fee\nfor, warranty, support, indemnity or liability obligations to one or more\nrecipients of Covered Software. However, you may do so only on Your own\nbehalf, and not on behalf of the Initial Developer or any Contributor. You\nmust make it absolutely clear that any such warranty, support, indemnity or\nliability obligation is offered by You alone, and You hereby agree to\nindemnify the Initial Developer and every Contributor for any liability\nincurred by the Initial Developer or such Contributor as a result of warranty,\nsupport, indemnity or liability terms You offer.\n\n3.5. Distribution of Executable Versions.\n\nYou may distribute the Executable form of the Covered Software under the terms\nof this License or under the terms of a license of Your choice, which may\ncontain terms different from this License, provided that You are in compliance\nwith the terms of this License and that the license for the Executable form\ndoes not attempt to limit or alter the recipient\u2019s rights in the Source Code\nform from the rights set forth in this License. If You distribute the Covered\nSoftware in Executable form under a different license, You must make it\nabsolutely clear that any terms which differ from this License are offered by\nYou alone, not by the Initial Developer or Contributor. You hereby agree to\nindemnify the Initial Developer and every Contributor for any liability\nincurred by the Initial Developer or such Contributor as a result of any such\nterms You offer.\n\n3.6. Larger Works.\n\nYou may create a Larger Work by combining Covered Software with other code not\ngoverned by the terms of this License and distribute the Larger Work as a\nsingle product. In such a case, You must make sure the requirements of this\nLicense are fulfilled for the Covered Software.\n\n4. Versions of the License. \n\n4.1. New Versions.\n\nSun Microsystems, Inc. is the initial license steward and may publish revised\nand/or new versions of this License from time to time. Each version will be\ngiven a distinguishing version number. Except as provided in Section 4.3, no\none other than the license steward has the right to modify this License.\n\n4.2. Effect of New Versions.\n\nYou may always continue to use, distribute or otherwise make the Covered\nSoftware available under the terms of the version of the License under which\nYou originally received the Covered Software. If the Initial Developer\nincludes a notice in the Original Software prohibiting it from being\ndistributed or otherwise made available under any subsequent version of the\nLicense, You must distribute and make the Covered Software available under the\nterms of the version of the License under which You originally received the\nCovered Software. Otherwise, You may also choose to use, distribute or\notherwise make the Covered Software available under the terms of any\nsubsequent version of the License published by the license steward.\n\n4.3. Modified Versions.\n\nWhen You are an Initial Developer and You want to create a new license for\nYour Original Software, You may create and use a modified version of this\nLicense if You: (a) rename the license and remove any references to the name\nof the license steward (except to note that the license differs from this\nLicense); and (b) otherwise make it clear that the license contains terms\nwhich differ from this License.\n\n5. DISCLAIMER OF WARRANTY. \n\nCOVERED SOFTWARE IS PROVIDED UNDER THIS LICENSE ON AN \u201cAS IS\u201d BASIS, WITHOUT\nWARRANTY OF ANY KIND, EITHER EXPRESSED OR IMPLIED, INCLUDING, WITHOUT\nLIMITATION, WARRANTIES THAT THE COVERED SOFTWARE IS FREE OF DEFECTS,\nMERCHANTABLE, FIT FOR A PARTICULAR PURPOSE OR NON-INFRINGING. THE ENTIRE RISK\nAS TO THE QUALITY AND PERFORMANCE OF THE COVERED SOFTWARE IS WITH YOU. SHOULD\nANY COVERED SOFTWARE PROVE DEFECTIVE IN ANY RESPECT, YOU (NOT THE INITIAL\nDEVELOPER OR ANY OTHER CONTRIBUTOR) ASSUME THE COST OF ANY NECESSARY\nSERVICING, REPAIR OR CORRECTION. THIS DISCLAIMER OF WARRANTY CONSTITUTES AN\nESSENTIAL PART OF THIS LICENSE. NO USE OF ANY COVERED SOFTWARE IS AUTHORIZED\nHEREUNDER EXCEPT UNDER THIS DISCLAIMER.\n\n6. TERMINATION. \n\n6.1. This License and the rights granted hereunder will terminate\nautomatically if You fail to comply with terms herein and fail to cure such\nbreach within 30 days of becoming aware of the breach. Provisions which, by\ntheir nature, must remain in effect beyond the termination of this License\nshall survive.\n\n6.2. If You assert a patent infringement claim (excluding declaratory judgment\nactions) against Initial Developer or a Contributor (the Initial Developer or\nContributor against whom You assert such claim is referred to as\n\u201cParticipant\u201d) alleging that the Participant Software (meaning the Contributor\nVersion where the Participant is a Contributor or the Original Software where\nthe Participant is the Initial Developer) directly or indirectly infringes any\npatent, then any and all rights granted directly or indirectly to You by such\nParticipant, the Initial Developer (if the Initial Developer is not the\nParticipant) and all Contributors under Sections 2.1 and/or 2.2 of this\nLicense shall, upon 60 days notice from Participant terminate prospectively\nand automatically at the expiration of such 60 day notice period, unless if\nwithin such 60 day period You withdraw Your claim with respect to the\nParticipant Software against such Participant either unilaterally or pursuant\nto a written agreement with Participant.\n\n6.3. In the event of termination under Sections 6.1 or 6.2 above, all end user\nlicenses that have been validly granted by You or any distributor hereunder\nprior to termination (excluding licenses granted to You by any distributor)\nshall survive termination.\n\n7. LIMITATION OF LIABILITY. \n\nUNDER NO CIRCUMSTANCES AND UNDER NO LEGAL THEORY, WHETHER TORT (INCLUDING\nNEGLIGENCE), CONTRACT, OR OTHERWISE, SHALL YOU, THE INITIAL DEVELOPER, ANY\nOTHER CONTRIBUTOR, OR ANY DISTRIBUTOR OF COVERED SOFTWARE, OR ANY SUPPLIER OF\nANY OF SUCH PARTIES, BE LIABLE TO ANY PERSON FOR ANY INDIRECT, SPECIAL,\nINCIDENTAL, OR CONSEQUENTIAL DAMAGES OF ANY CHARACTER INCLUDING, WITHOUT\nLIMITATION, DAMAGES FOR LOST PROFITS, LOSS OF GOODWILL, WORK STOPPAGE,\nCOMPUTER FAILURE OR MALFUNCTION, OR ANY AND ALL OTHER COMMERCIAL DAMAGES OR\nLOSSES, EVEN IF SUCH PARTY SHALL HAVE BEEN INFORMED OF THE POSSIBILITY OF SUCH\nDAMAGES. THIS LIMITATION OF LIABILITY SHALL NOT APPLY TO LIABILITY FOR DEATH\nOR PERSONAL INJURY RESULTING FROM SUCH PARTY\u2019S NEGLIGENCE TO THE EXTENT\nAPPLICABLE LAW PROHIBITS SUCH LIMITATION. SOME JURISDICTIONS DO NOT ALLOW THE\nEXCLUSION OR LIMITATION OF INCIDENTAL OR CONSEQUENTIAL DAMAGES, SO THIS\nEXCLUSION AND LIMITATION MAY NOT APPLY TO YOU.\n\n8. U.S. GOVERNMENT END USERS. \n\nThe Covered Software is a \u201ccommercial item,\u201d as that term is defined in 48\nC.F.R. 2.101 (Oct. 1995), consisting of \u201ccommercial computer software\u201d (as\nthat term is defined at 48 C.F.R. \u00a7 252.227-7014(a)(1)) and \u201ccommercial\ncomputer software documentation\u201d as such terms are used in 48 C.F.R. 12.212\n(Sept. 1995). Consistent with 48 C.F.R. 12.212 and 48 C.F.R. 227.7202-1\nthrough 227.7202-4 (June 1995), all U.S. Government End Users acquire Covered\nSoftware with only those rights set forth herein. This U.S. Government Rights\nclause is in lieu of, and supersedes, any other FAR, DFAR, or other clause or\nprovision that addresses Government rights in computer software under this\nLicense.\n\n9. MISCELLANEOUS. \n\nThis License represents the complete agreement concerning subject matter\nhereof. If any provision of this License is held to be unenforceable, such\nprovision shall be reformed only to the extent necessary to make it\nenforceable. This License shall be governed by the law of the jurisdiction\nspecified in a notice contained within the Original Software (except to the\nextent applicable law, if any, provides otherwise), excluding such\njurisdiction\u2019s conflict-of-law provisions. Any litigation relating to this\nLicense shall be subject to the jurisdiction of the courts located in the\njurisdiction and venue specified in a notice contained within the Original\nSoftware, with the losing party responsible for costs, including, without\nlimitation, court costs and reasonable attorneys\u2019 fees and expenses. The\napplication of the United Nations Convention on Contracts for the\nInternational Sale of Goods is expressly excluded. Any law or regulation which\nprovides that the language of a contract shall be construed against the\ndrafter shall not apply to this License. You agree that You alone are\nresponsible for compliance with the United States export administration\nregulations (and the export control laws and regulation of any other\ncountries) when You use, distribute or otherwise make available any Covered\nSoftware.\n\n10. RESPONSIBILITY FOR CLAIMS. \n\nAs between Initial Developer and the Contributors, each party is responsible\nfor claims and damages arising, directly or indirectly, out of its utilization\nof rights under this License and You agree to work with Initial Developer and\nContributors to distribute such responsibility on an equitable basis. Nothing\nherein is intended or shall be deemed to constitute any admission of\nliability.\n\n" +
+	"", etag: `"//1JlWEGRGU="`})
@@ -0,0 +1,7 @@
+// AUTOMATICALLY GENERATED FILE. DO NOT EDIT.
+
+package assets
+
+var spdx_cdla_permissive_1_0 = txt(asset{Name: "spdx_cdla_permissive_1_0.txt", Content: "" +
+	"---\ntitle: Community Data License Agreement Permissive 1.0\nspdx-id: CDLA-Permissive-1.0\nsource: https://spdx.org/licenses/CDLA-Permissive-1.0.html\nosi-approved: false\nfsf-libre: false\n---\n\nCommunity Data License Agreement - Permissive - Version 1.0\n\nThis is the Community Data License Agreement \u2013 Permissive, Version 1.0\n\n(\u201cAgreement\u201d). Data is provided to You under this Agreement by each of the Data\nProviders. Your exercise of any of the rights and permissions granted below constitutes\nYour acceptance and agreement to be bound by the terms and conditions of this\nAgreement.\n\nThe benefits that each Data Provider receives from making Data available and that You\nreceive from Data or otherwise under these terms and conditions shall be deemed\nsufficient consideration for the formation of this Agreement. Accordingly, Data\nProvider(s) and You (the \u201cParties\u201d) agree as follows:\n\nSection 1. Definitions\n\n1.1 \u201cAdd\u201d means to supplement Data with Your own or someone else\u2019s Data, resulting in\nYour \u201cAdditions.\u201d Additions do not include Results.\n\n1.2 \u201cComputational Use\u201d means Your analysis (through the use of computational devices\nor otherwise) or other interpretation of Data. By way of example and not limitation,\n\u201cComputational Use\u201d includes the application of any computational analytical technique,\nthe purpose of which is the analysis of any Data in digital form to generate information\nabout Data such as patterns, trends, correlations, inferences, insights and attributes.\n\n1.3 \u201cData\u201d means the information (including copyrightable information, such as images\nor text), collectively or individually, whether created or gathered by a Data Provider or an\nEntity acting on its behalf, to which rights are granted under this Agreement.\n\n1.4 \u201cData Provider\u201d means any Entity (including any employee or contractor of such\nEntity authorized to Publish Data on behalf of such Entity) that Publishes Data under this\nAgreement prior to Your Receiving it.\n\n1.5 \u201cEnhanced Data\u201d means the subset of Data that You Publish and that is composed of\n(a) Your Additions and/or (b) Modifications to Data You have received under this\nAgreement.\n\n1.6 \u201cEntity\u201d means any natural person or organization that exists under the laws of the\njurisdiction in which it is organized, together with all other entities that control, are\ncontrolled by, or are under common control with that entity. For the purposes of this\ndefinition, \u201ccontrol\u201d means (a) the power, directly or indirectly, to cause the direction or\nmanagement of such entity, whether by contract or otherwise, (b) the ownership of more\nthan fifty percent (50%) of the outstanding shares or securities, (c) the beneficial\nownership of such entity or, (d) the ability to appoint, whether by agreement or right, the\nmajority of directors of an Entity.\n\n1.7 \u201cModify\u201d means to delete, erase, correct or re-arrange Data, resulting in\n\u201cModifications.\u201d Modifications do not include Results.\n\n1.8 \u201cPublish\u201d means to make all or a subset of Data (including Your Enhanced Data)\navailable in any manner which enables its Use, including by providing a copy on physical\nmedia or remote access. For any form of Entity, that is to make the Data available to any\nindividual who is not employed by that Entity or engaged as a contractor or agent to\nperform work on that Entity\u2019s behalf. A \u201cPublication\u201d occurs each time You Publish\nData.\n\n1.9 \u201cReceive\u201d or \u201cReceives\u201d means to have been given access to Data, locally or\nremotely.\n\n1.10 \u201cResults\u201d means the outcomes or outputs that You obtain from Your Computational\nUse of Data. Results shall not include more than a de minimis portion of the Data on\nwhich the Computational Use is based.\n\n1.11 \u201cSui Generis Database Rights\u201d means rights, other than copyright, resulting from\nDirective 96/9/EC of the European Parliament and of the Council of 11 March 1996 on\nthe legal protection of databases, as amended and/or succeeded, as well as other\nequivalent rights anywhere in the world.\n\n1.12 \u201cUse\u201d means using Data (including accessing, copying, studying, reviewing,\nadapting, analyzing, evaluating, or making Computational Use of it), either by machines\nor humans, or a combination of both.\n\n1.13 \u201cYou\u201d or \u201cYour\u201d means any Entity that Receives Data under this Agreement.\n\nSection 2. Right and License to Use and to Publish\n\n2.1 Subject to the conditions set forth in Section 3 of this Agreement, Data Provider(s)\nhereby grant(s) to You a worldwide, non-exclusive, irrevocable (except as provided in\nSection 5) right to: (a) Use Data; and (b) Publish Data.\n\n2.2 To the extent that the Data or the coordination, selection or arrangement of Data is\nprotected or protectable under copyright, Sui Generis Database Rights, or other law, Data\nProvider(s) further agree(s) that such Data or coordination, selection or arrangement is\nhereby licensed to You and to anyone else who Receives Data under this Agreement for\nUse and Publication, subject to the conditions set forth in Section 3 of this Agreement.\n\n2.3 Except for these rights and licenses expressly granted, no other intellectual property\nrights are granted or should be implied.\n\nSection 3. Conditions on Rights Granted\n\n3.1 If You Publish Data You Receive or Enhanced Data:\n\n(a) You may do so under a license of Your choice provided that You give anyone\nwho Receives the Data from You the text of this Agreement, the name of this\nAgreement and/or a hyperlink or other method reasonably likely to provide a\ncopy of the text of this Agreement; and\n\n(b) You must cause any Data files containing Enhanced Data to carry prominent\nnotices that You have changed those files; and\n\n(c) If You Publish Data You Receive, You must preserve all credit or attribution\nto the Data Provider(s). Such retained credit or attribution includes any of the\nfollowing to the extent they exist in Data as You have Received it: legal\nnotices or metadata; identification of the Data Provider(s); or hyperlinks to\nData to the extent it is practical to do so.\n\n3.2 You may provide additional or different license terms and conditions for use,\nreproduction, or distribution of that Enhanced Data, or for any combination of Data and\nEnhanced Data as a whole, provided that Your Use and Publication of that combined\nData otherwise complies with the conditions stated in this License.\n\n3.3 You and each Data Provider agree that Enhanced Data shall not be considered a work\nof joint authorship by virtue of its relationship to Data licensed under this Agreement and\nshall not require either any obligation of accounting to or the consent of any Data\nProvider.\n\n3.4 This Agreement imposes no obligations or restrictions on Your Use or Publication of\nResults.\n\nSection 4. Data Provider(s)\u2019 Representations\n\n4.1 Each Data Provider represents that the Data Provider has exercised reasonable care,\nto assure that: (a) the Data it Publishes was created or generated by it or was obtained\nfrom others with the right to Publish the Data under this Agreement; and (b) Publication\nof such Data does not violate any privacy or confidentiality obligation undertaken by the\nData Provider.\n\nSection 5. Termination\n\n5.1 All of Your rights under this Agreement will terminate, and Your right to Receive,\nUse or Publish the Data will be revoked or modified if You materially fail to comply with\nthe terms and conditions of this Agreement and You do not cure such failure in a\nreasonable period of time after becoming aware of such noncompliance. If Your rights\nunder this Agreement terminate, You agree to cease Receipt, Use and Publication of\nData. However, Your obligations and any rights and permissions granted by You under\nthis Agreement relating to Data that You Published prior to such termination will\ncontinue and survive.\n\n5.2 If You institute litigation against a Data Provider or anyone else who Receives the\nData (including a cross-claim in a lawsuit) based on the Data, other than a claim asserting\nbreach of this Agreement, then any rights previously granted to You to Receive, Use and\nPublish Data under this Agreement will terminate as of the date such litigation is filed.\n\nSection 6. Disclaimer of Warranties and Limitation of Liability\n\n6.1 EXCEPT AS EXPRESSLY SET FORTH IN THIS AGREEMENT, THE DATA\n(INCLUDING ENHANCED DATA) IS PROVIDED ON AN \u201cAS IS\u201d BASIS,\nWITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, EITHER EXPRESS\nOR IMPLIED INCLUDING, WITHOUT LIMITATION, ANY WARRANTIES OR\nCONDITIONS OF TITLE, NON-INFRINGEMENT, MERCHANTABILITY OR\nFITNESS FOR A PARTICULAR PURPOSE.\n\n6.2 NEITHER YOU NOR ANY DATA PROVIDERS SHALL HAVE ANY LIABILITY\nFOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR\nCONSEQUENTIAL DAMAGES (INCLUDING WITHOUT LIMITATION LOST\nPROFITS), HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY,\nWHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING\nNEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OR\nDISTRIBUTION OF THE DATA OR THE EXERCISE OF ANY RIGHTS GRANTED\nHEREUNDER, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGES.\n\nSection 7. Miscellaneous\n\n7.1 You agree that it is solely Your responsibility to comply with all applicable laws with\nregard to Your Use or Publication of Data, including any applicable privacy, data\nprotection, security and export laws. You agree to take reasonable steps to assist a Data\nProvider fulfilling responsibilities to comply with applicable laws with regard to Use or\nPublication of Data Received hereunder.\n\n7.2 You and Data Provider(s), collectively and individually, waive and/or agree not to\nassert, to the extent permitted by law, any moral rights You or they hold in Data.\n\n7.3 This Agreement confers no rights or remedies upon any person or entity other than\nthe Parties and their respective heirs, executors, successors and assigns.\n\n7.4 The Data Provider(s) reserve no right or expectation of privacy, data protection or\nconfidentiality in any Data that they Publish under this Agreement. If You choose to\nPublish Data under this Agreement, You similarly do so with no reservation or\nexpectation of any rights of privacy or confidentiality in that Data.\n\n7.5 The Community Data License Agreement workgroup under The Linux Foundation is\nthe steward of this Agreement (\u201cSteward\u201d). No one other than the Steward has the right\nto modify or publish new versions of this Agreement. Each version will be given a\ndistinguishing version number. You may Use and Publish Data Received hereunder\nunder the terms of the version of the Agreement under which You originally Received the\nData, or under the terms of any subsequent version published by the Steward.\n" +
+	"", etag: `"5U4dC+8/m84="`})
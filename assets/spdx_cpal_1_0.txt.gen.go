@@ -0,0 +1,7 @@
+// AUTOMATICALLY GENERATED FILE. DO NOT EDIT.
+
+package assets
+
+var spdx_cpal_1_0 = txt(asset{Name: "spdx_cpal_1_0.txt", Content: "" +
+	"---\ntitle: Common Public Attribution License 1.0\nspdx-id: CPAL-1.0\nsource: https://spdx.org/licenses/CPAL-1.0.html\nosi-approved: true\nfsf-libre: false\n---\n\nCommon Public Attribution License Version 1.0 (CPAL)\n\n1. \u201cDefinitions\u201d \n\n1.0.1 \u201cCommercial Use\u201d means distribution or otherwise making the Covered Code\navailable to a third party.\n\n1.1 \u201cContributor\u201d means each entity that creates or contributes to the\ncreation of Modifications.\n\n1.2 \u201cContributor Version\u201d means the combination of the Original Code, prior\nModifications used by a Contributor, and the Modifications made by that\nparticular Contributor.\n\n1.3 \u201cCovered Code\u201d means the Original Code or Modifications or the combination\nof the Original Code and Modifications, in each case including portions\nthereof.\n\n1.4 \u201cElectronic Distribution Mechanism\u201d means a mechanism generally accepted\nin the software development community for the electronic transfer of data.\n\n1.5 \u201cExecutable\u201d means Covered Code in any form other than Source Code.\n\n1.6 \u201cInitial Developer\u201d means the individual or entity identified as the\nInitial Developer in the Source Code notice required by Exhibit A.\n\n1.7 \u201cLarger Work\u201d means a work which combines Covered Code or portions thereof\nwith code not governed by the terms of this License.\n\n1.8 \u201cLicense\u201d means this document.\n\n1.8.1 \u201cLicensable\u201d means having the right to grant, to the maximum extent\npossible, whether at the time of the initial grant or subsequently acquired,\nany and all of the rights conveyed herein.\n\n1.9 \u201cModifications\u201d means any addition to or deletion from the substance or\nstructure of either the Original Code or any previous Modifications. When\nCovered Code is released as a series of files, a Modification is:\n\nA. Any addition to or deletion from the contents of a file containing Original\nCode or previous Modifications.\n\nB. Any new file that contains any part of the Original Code or previous\nModifications.\n\n1.10 \u201cOriginal Code\u201d means Source Code of computer software code which is\ndescribed in the Source Code notice required by Exhibit A as Original Code,\nand which, at the time of its release under this License is not already\nCovered Code governed by this License.\n\n1.10.1 \u201cPatent Claims\u201d means any patent claim(s), now owned or hereafter\nacquired, including without limitation, method, process, and apparatus claims,\nin any patent Licensable by grantor.\n\n1.11 \u201cSource Code\u201d means the preferred form of the Covered Code for making\nmodifications to it, including all modules it contains, plus any associated\ninterface definition files, scripts used to control compilation and\ninstallation of an Executable, or source code differential comparisons against\neither the Original Code or another well known, available Covered Code of the\nContributor\u2019s choice. The Source Code can be in a compressed or archival form,\nprovided the appropriate decompression or de-archiving software is widely\navailable for no charge.\n\n1.12 \u201cYou\u201d (or \u201cYour\u201d) means an individual or a legal entity exercising rights\nunder, and complying with all of the terms of, this License or a future\nversion of this License issued under Section 6.1. For legal entities, \u201cYou\u201d\nincludes any entity which controls, is controlled by, or is under common\ncontrol with You. For purposes of this definition, \u201ccontrol\u201d means (a) the\npower, direct or indirect, to cause the direction or management of such\nentity, whether by contract or otherwise, or (b) ownership of more than fifty\npercent (50%) of the outstanding shares or beneficial ownership of such\nentity.\n\n2. Source Code License. \n\n2.1 The Initial Developer Grant.\n\nThe Initial Developer hereby grants You a world-wide, royalty-free, non-\nexclusive license, subject to third party intellectual property claims:\n\n(a) under intellectual property rights (other than patent or trademark)\nLicensable by Initial Developer to use, reproduce, modify, display, perform,\nsublicense and distribute the Original Code (or portions thereof) with or\nwithout Modifications, and/or as part of a Larger Work; and\n\n(b) under Patents Claims infringed by the making, using or selling of Original\nCode, to make, have made, use, practice, sell, and offer for sale, and/or\notherwise dispose of the Original Code (or portions thereof).\n\n(c) the licenses granted in this Section 2.1(a) and (b) are effective on the\ndate Initial Developer first distributes Original Code under the terms of this\nLicense.\n\n(d) Notwithstanding Section 2.1(b) above, no patent license is granted: 1) for\ncode that You delete from the Original Code; 2) separate from the Original\nCode; or 3) for infringements caused by: i) the modification of the Original\nCode or ii) the combination of the Original Code with other software or\ndevices.\n\n2.2 Contributor Grant.\n\nSubject to third party intellectual property claims, each Contributor hereby\ngrants You a world-wide, royalty-free, non-exclusive license\n\n(a) under intellectual property rights (other than patent or trademark)\nLicensable by Contributor, to use, reproduce, modify, display, perform,\nsublicense and distribute the Modifications created by such Contributor (or\nportions thereof) either on an unmodified basis, with other Modifications, as\nCovered Code and/or as part of a Larger Work; and\n\n(b) under Patent Claims infringed by the making, using, or selling of\nModifications made by that Contributor either alone and/or in combination with\nits Contributor Version (or portions of such combination), to make, use, sell,\noffer for sale, have made, and/or otherwise dispose of: 1) Modifications made\nby that Contributor (or portions thereof); and 2) the combination of\nModifications made by that Contributor with its Contributor Version (or\nportions of such combination).\n\n(c) the licenses granted in Sections 2.2(a) and 2.2(b) are effective on the\ndate Contributor first makes Commercial Use of the Covered Code.\n\n(d) Notwithstanding Section 2.2(b) above, no patent license is granted: 1) for\nany code that Contributor has deleted from the Contributor Version; 2)\nseparate from the Contributor Version; 3) for infringements caused by: i)\nthird party modifications of Contributor Version or ii) the combination of\nModifications made by that Contributor with other software (except as part of\nthe Contributor Version) or other devices; or 4) under Patent Claims infringed\nby Covered Code in the absence of Modifications made by that Contributor.\n\n3. Distribution Obligations. \n\n3.1 Application of License.\n\nThe Modifications which You create or to which You contribute are governed by\nthe terms of this License, including without limitation Section 2.2. The\nSource Code version of Covered Code may be distributed only under the terms of\nthis License or a future version of this License released under Section 6.1,\nand You must include a copy of this License with every copy of the Source Code\nYou distribute. You may not offer or impose any terms on any Source Code\nversion that alters or restricts the applicable version of this License or the\nrecipients\u2019 rights hereunder. However, You may include an additional document\noffering the additional rights described in Section 3.5.\n\n3.2 Availability of Source Code.\n\nAny Modification which You create or to which You contribute must be made\navailable in Source Code form under the terms of this License either on the\nsame media as an Executable version or via an accepted Electronic Distribution\nMechanism to anyone to whom you made an Executable version available; and if\nmade available via Electronic Distribution Mechanism, must remain available\nfor at least twelve (12) months after the date it initially became available,\nor at least six (6) months after a subsequent version of that particular\nModification has been made available to such recipients. You are responsible\nfor ensuring that the Source Code version remains available even if the\nElectronic Distribution Mechanism is maintained by a third party.\n\n3.3 Description of Modifications.\n\nYou must cause all Covered Code to which You contribute to contain a file\ndocumenting the changes You made to create that Covered Code and the date of\nany change. You must include a prominent statement that the Modification is\nderived, directly or indirectly, from Original Code provided by the Initial\nDeveloper and including the name of the Initial Developer in (a) the Source\nCode, and (b) in any notice in an Executable version or related documentation\nin which You describe the origin or ownership of the Covered Code.\n\n3.4 Intellectual Property Matters\n\n(a) Third Party Claims. If Contributor has knowledge that a license under a\nthird party\u2019s intellectual property rights is required to exercise the rights\ngranted by such Contributor under Sections 2.1 or 2.2, Contributor must\ninclude a text file with the Source Code distribution titled \u201cLEGAL\u201d which\ndescribes the claim and the party making the claim in sufficient detail that a\nrecipient will know whom to contact. If Contributor obtains such knowledge\nafter the Modification is made available as described in Section 3.2,\nContributor shall promptly modify the LEGAL file in all copies Contributor\nmakes available thereafter and shall take other steps (such as notifying\nappropriate mailing lists or newsgroups) reasonably calculated to inform those\nwho received the Covered Code that new knowledge has been obtained.\n\n(b) Contributor APIs. If Contributor\u2019s Modifications include an application\nprogramming interface and Contributor has knowledge of patent licenses which\nare reasonably necessary to implement that API, Contributor must also include\nthis information in the LEGAL file.\n\n(c) Representations. Contributor represents that, except as disclosed pursuant\nto Section 3.4(a) above, Contributor believes that Contributor\u2019s Modifications\nare Contributor\u2019s original creation(s) and/or Contributor has sufficient\nrights to grant the rights conveyed by this License.\n\n3.5 Required Notices.\n\nYou must duplicate the notice in Exhibit A in each file of the Source Code. If\nit is not possible to put such notice in a particular Source Code file due to\nits structure, then You must include such notice in a location (such as a\nrelevant directory) where a user would be likely to look for such a notice. If\nYou created one or more Modification(s) You may add your name as a Contributor\nto the notice described in Exhibit A. You must also duplicate this License in\nany documentation for the Source Code where You describe recipients\u2019 rights or\nownership rights relating to Covered Code. You may choose to offer, and to\ncharge a fee for, warranty, support, indemnity or liability obligations to one\nor more recipients of Covered Code. However, You may do so only on Your own\nbehalf, and not on behalf of the Initial Developer or any Contributor. You\nmust make it absolutely clear than any such warranty, support, indemnity or\nliability obligation is offered by You alone, and You hereby agree to\nindemnify the Initial Developer and every Contributor for any liability\nincurred by the Initial Developer or such Contributor as a result of warranty,\nsupport, indemnity or liability terms You offer.\n\n3.6 Distribution of Executable Versions.\n\nYou may distribute Covered Code in Executable form only if the requirements of\nSection 3.1-3.5 have been met for that Covered Code, and if You include a\nnotice stating that the Source Code version of the Covered Code is available\nunder the terms of this License, including a description of how and where You\nhave fulfilled the obligations of Section 3.2. The notice must be\nconspicuously included in any notice in an Executable version, related\ndocumentation or collateral in which You describe recipients\u2019 rights relating\nto the Covered Code. You may distribute the Executable version of Covered Code\nor ownership rights under a license of Your choice, which may contain terms\ndifferent from this License, provided that You are in compliance with the\nterms of this License and that the license for the Executable version does not\nattempt to limit or alter the recipient\u2019s rights in the Source Code version\nfrom the rights set forth in this License. If You distribute the Executable\nversion under a different license You must make it absolutely clear that any\nterms which differ from this License are offered by You alone, not by the\nInitial Developer, Original Developer or any Contributor. You hereby agree to\nindemnify the Initial Developer, Original Developer and every Contributor for\nany liability incurred by the Initial Developer, Original Developer or such\nContributor as a result of any such terms You offer.\n\n3.7 Larger Works.\n\nYou may create a Larger Work by combining Covered Code with other code not\ngoverned by the terms of this License and distribute the Larger Work as a\nsingle product. In such a case, You must make sure the requirements of this\nLicense are fulfilled for the Covered Code.\n\n4. Inability to Comply Due to Statute or Regulation.   \nIf it is impossible for You to comply with any of the terms of this License\nwith respect to some or all of the Covered Code due to statute, judicial\norder, or regulation then You must: (a) comply with the terms of this License\nto the maximum extent possible; and (b) describe the limitations and the code\nthey affect. Such description must be included in the LEGAL file described in\nSection 3.4 and must be included with all distributions of the Source Code.\nExcept to the extent prohibited by statute or regulation, such description\nmust be sufficiently detailed for a recipient of ordinary skill to be able to\nunderstand it.\n\n5. Application of this License.   \nThis License applies to code to which the Initial Developer has attached the\nnotice in Exhibit A and to related Covered Code.\n\n6. Versions of the License. \n\n6.1 New Versions.\n\nSocialtext, Inc. (\u201cSocialtext\u201d) may publish revised and/or new versions of the\nLicense from time to time. Each version will be given a distinguishing version\nnumber.\n\n6.2 Effect of New Versions.\n\nOnce Covered Code has been published under a particular version of the\nLicense, You may always continue to use it under the terms of that version.\nYou may also choose to use such Covered Code under the terms of any subsequent\nversion of the License published by Socialtext. No one other than Socialtext\nhas the right to modify the terms applicable to Covered Code created under\nthis License.\n\n6.3 Derivative Works.\n\nIf You create or use a modified version of this License (which you may only do\nin order to apply it to code which is not already Covered Code governed by\nthis License), You must (a) rename Your license so that the phrases\n\u201cSocialtext\u201d, \u201cCPAL\u201d or any confusingly similar phrase do not appear in your\nlicense (except to note that your license differs from this License) and (b)\notherwise make it clear that Your version of the license contains terms which\ndiffer from the CPAL. (Filling in the name of the Initial Developer, Original\nDeveloper, Original Code or Contributor in the notice described in Exhibit A\nshall not of themselves be deemed to be modifications of this License.)\n\n7. DISCLAIMER OF WARRANTY.   \nCOVERED CODE IS PROVIDED UNDER THIS LICENSE ON AN \u201cAS IS\u201d BASIS, WITHOUT\nWARRANTY OF ANY KIND, EITHER EXPRESSED OR IMPLIED, INCLUDING, WITHOUT\nLIMITATION, WARRANTIES THAT THE COVERED CODE IS FREE OF DEFECTS, MERCHANTABLE,\nFIT FOR A PARTICULAR PURPOSE OR NON-INFRINGING. THE ENTIRE RISK AS TO THE\nQUALITY AND PERFORMANCE OF THE COVERED CODE IS WITH YOU. SHOULD ANY COVERED\nCODE PROVE DEFECTIVE IN ANY RESPECT, YOU (NOT THE INITIAL DEVELOPER, ORIGINAL\nDEVELOPER OR ANY OTHER CONTRIBUTOR) ASSUME THE COST OF ANY NECESSARY\nSERVICING, REPAIR OR CORRECTION. THIS DISCLAIMER OF WARRANTY CONSTITUTES AN\nESSENTIAL PART OF THIS LICENSE. NO USE OF ANY COVERED CODE IS AUTHORIZED\nHEREUNDER EXCEPT UNDER THIS DISCLAIMER.\n\n8. TERMINATION. \n\n8.1 This License and the rights granted hereunder will terminate automatically\nif You fail to comply with terms herein and fail to cure such breach within 30\ndays of becoming aware of the breach. All sublicenses to the Covered Code\nwhich are properly granted shall survive any termination of this License.\nProvisions which, by their nature, must remain in effect beyond the\ntermination of this License shall survive.\n\n8.2 If You initiate litigation by asserting a patent infringement claim\n(excluding declatory judgment actions) against Initial Developer, Original\nDeveloper or a Contributor (the Initial Developer, Original Developer or\nContributor against whom You file such action is referred to as \u201cParticipant\u201d)\nalleging that:\n\n(a) such Participant\u2019s Contributor Version directly or indirectly infringes\nany patent, then any and all rights granted by such Participant to You under\nSections 2.1 and/or 2.2 of this License shall, upon 60 days notice from\nParticipant terminate prospectively, unless if within 60 days after receipt of\nnotice You either: (i) agree in writing to pay Participant a mutually\nagreeable reasonable royalty for Your past and future use of Modifications\nmade by such Participant, or (ii) withdraw Your litigation claim with respect\nto the Contributor Version against such Participant. If within 60 days of\nnotice, a reasonable royalty and payment arrangement are not mutually agreed\nupon in writing by the parties or the litigation claim is not withdrawn, the\nrights granted by Participant to You under Sections 2.1 and/or 2.2\nautomatically terminate at the expiration of the 60 day notice period\nspecified above.\n\n(b) any software, hardware, or device, other than such Participant\u2019s\nContributor Version, directly or indirectly infringes any patent, then any\nrights granted to You by such Participant under Sections 2.1(b) and 2.2(b) are\nrevoked effective as of the date You first made, used, sold, distributed, or\nhad made, Modifications made by that Participant.\n\n8.3 If You assert a patent infringement claim against Participant alleging\nthat such Participant\u2019s Contributor Version directly or indirectly infringes\nany patent where such claim is resolved (such as by license or settlement)\nprior to the initiation of patent infringement litigation, then the reasonable\nvalue of the licenses granted by such Participant under Sections 2.1 or 2.2\nshall be taken into account in determining the amount or value of any payment\nor license.\n\n8.4 In the event of termination under Sections 8.1 or 8.2 above, all end user\nlicense agreements (excluding distributors and resellers) which have been\nvalidly granted by You or any distributor hereunder prior to termination shall\nsurvive termination.\n\n9. LIMITATION OF LIABILITY.   \nUNDER NO CIRCUMSTANCES AND UNDER NO LEGAL THEORY, WHETHER TORT (INCLUDING\nNEGLIGENCE), CONTRACT, OR OTHERWISE, SHALL YOU, THE INITIAL DEVELOPER,\nORIGINAL DEVELOPER, ANY OTHER CONTRIBUTOR, OR ANY DISTRIBUTOR OF COVERED CODE,\nOR ANY SUPPLIER OF ANY OF SUCH PARTIES, BE LIABLE TO ANY PERSON FOR ANY\nINDIRECT, SPECIAL, INCIDENTAL, OR CONSEQUENTIAL DAMAGES OF ANY CHARACTER\nINCLUDING, WITHOUT LIMITATION, DAMAGES FOR LOSS OF GOODWILL, WORK STOPPAGE,\nCOMPUTER FAILURE OR MALFUNCTION, OR ANY AND ALL OTHER COMMERCIAL DAMAGES OR\nLOSSES, EVEN IF SUCH PARTY SHALL HAVE BEEN INFORMED OF THE POSSIBILITY OF SUCH\nDAMAGES. THIS LIMITATION OF LIABILITY SHALL NOT APPLY TO LIABILITY FOR DEATH\nOR PERSONAL INJURY RESULTING FROM SUCH PARTY\u2019S NEGLIGENCE TO THE EXTENT\nAPPLICABLE LAW PROHIBITS SUCH LIMITATION. SOME JURISDICTIONS DO NOT ALLOW THE\nEXCLUSION OR LIMITATION OF INCIDENTAL OR CONSEQUENTIAL DAMAGES, SO THIS\nEXCLUSION AND LIMITATION MAY NOT APPLY TO YOU.\n\n10. U.S. GOVERNMENT END USERS.   \nThe Covered Code is a \u201ccommercial item,\u201d as that term is defined in 48 C.F.R.\n2.101 (Oct. 1995), consisting of \u201ccommercial computer software\u201d and\n\u201ccommercial computer software documentation,\u201d as such terms are used in 48\nC.F.R. 12.212 (Sept. 1995). Consistent with 48 C.F.R. 12.212 and 48 C.F.R.\n227.7202-1 through 227.7202-4 (June 1995), all U.S. Government End Users\nacquire Covered Code with only those rights set forth herein.\n\n11. MISCELLANEOUS.   \nThis License represents the complete agreement concerning subject matter\nhereof. If any provision of this License is held to be unenforceable, such\nprovision shall be reformed only to the extent necessary to make it\nenforceable. This License shall be governed by California law provisions\n(except to the extent applicable law, if any, provides otherwise), excluding\nits conflict-of-law provisions. With respect to disputes in which at least one\nparty is a citizen of, or an entity chartered or registered to do business in\nthe United States of America, any litigation relating to this License shall be\nsubject to the jurisdiction of the Federal Courts of the Northern District of\nCalifornia, with venue lying in Santa Clara County, California, with the\nlosing party responsible for costs, including without limitation, court costs\nand reasonable attorneys\u2019 fees and expenses. The application of the United\nNations Convention on Contracts for the International Sale of Goods is\nexpressly excluded. Any law or regulation which provides that the language of\na contract shall be construed against the drafter shall not apply to this\nLicense.\n\n12. RESPONSIBILITY FOR CLAIMS.   \nAs between Initial Developer, Original Developer and the Contributors, each\nparty is responsible for claims and damages arising, directly or indirectly,\nout of its utilization of rights under this License and You agree to work with\nInitial Developer, Original Developer and Contributors to distribute such\nresponsibility on an equitable basis. Nothing herein is intended or shall be\ndeemed to constitute any admission of liability.\n\n13. MULTIPLE-LICENSED CODE.   \nInitial Developer may designate portions of the Covered Code as Multiple-\nLicensed. Multiple-Licensed means that the Initial Developer permits you to\nutilize portions of the Covered Code under Your choice of the CPAL or the\nalternative licenses, if any, specified by the Initial Developer in the file\ndescribed in Exhibit A.\n\n14. ADDITIONAL TERM: ATTRIBUTION \n\n(a) As a modest attribution to the organizer of the development of the\nOriginal Code (\u201cOriginal Developer\u201d), in the hope that its promotional value\nmay help justify the time, money and effort invested in writing the Original\nCode, the Original Developer may include in Exhibit B (\u201cAttribution\nInformation\u201d) a requirement that each time an Executable and Source Code or a\nLarger Work is launched or initially run (which includes initiating a\nsession), a prominent display of the Original Developer\u2019s Attribution\nInformation (as defined below) must occur on the graphic user interface\nemployed by the end user to access such Covered Code (which may include\ndisplay on a splash screen), if any. The size of the graphic image should be\nconsistent with the size of the other elements of the Attribution Information.\nIf the access by the end user to the Executable and Source Code does not\ncreate a graphic user interface for access to the Covered Code, this\nobligation shall not apply. If the Original Code displays such Attribution\nInformation in a particular form (such as in the form of a splash screen,\nnotice at login, an \u201cabout\u201d display, or dedicated attribution area on user\ninterface screens), continued use of such form for that Attribution\nInformation is one way of meeting this requirement for notice.\n\n(b) Attribution information may only include a copyright notice, a brief\nphrase, graphic image and a URL (\u201cAttribution Information\u201d) and is subject to\nthe Attribution Limits as defined below. For these purposes, prominent shall\nmean display for sufficient duration to give reasonable notice to the user of\nthe identity of the Original Developer and that if You include Attribution\nInformation or similar information for other parties, You must ensure that the\nAttribution Information for the Original Developer shall be no less prominent\nthan such Attribution Information or similar information for the other party.\nFor greater certainty, the Original Developer may choose to specify in Exhibit\nB below that the above attribution requirement only applies to an Executable\nand Source Code resulting from the Original Code or any Modification, but not\na Larger Work. The intent is to provide for reasonably modest attribution,\ntherefore the Original Developer cannot require that You display, at any time,\nmore than the following information as Attribution Information: (a) a\ncopyright notice including the name of the Original Developer; (b) a word or\none phrase (not exceeding 10 words); (c) one graphic image provided by the\nOriginal Developer; and (d) a URL (collectively, the \u201cAttribution Limits\u201d).\n\n(c) If Exhibit B does not include any Attribution Information, then there are\nno requirements for You to display any Attribution Information of the Original\nDeveloper.\n\n(d) You acknowledge that all trademarks, service marks and/or trade names\ncontained within the Attribution Information distributed with the Covered Code\nare the exclusive property of their owners and may only be used with the\npermission of their owners, or under circumstances otherwise permitted by law\nor as expressly set out in this License.\n\n15. ADDITIONAL TERM: NETWORK USE.   \nThe term \u201cExternal Deployment\u201d means the use, distribution, or communication\nof the Original Code or Modifications in any way such that the Original Code\nor Modifications may be used by anyone other than You, whether those works are\ndistributed or communicated to those persons or made available as an\napplication intended for use over a network. As an express condition for the\ngrants of license hereunder, You must treat any External Deployment by You of\nthe Original Code or Modifications as a distribution under section 3.1 and\nmake Source Code available under Section 3.2.\n\nEXHIBIT A. Common Public Attribution License Version 1.0.\n\n\u201cThe contents of this file are subject to the Common Public Attribution\nLicense Version 1.0 (the \u201cLicense\u201d); you may not use this file except in\ncompliance with the License. You may obtain a copy of the License at\n_____________. The License is based on the Mozilla Public License Version 1.1\nbut Sections 14 and 15 have been added to cover use of software over a\ncomputer network and provide for limited attribution for the Original\nDeveloper. In addition, Exhibit A has been modified to be consistent with\nExhibit B.\n\nSoftware distributed under the License is distributed on an \u201cAS IS\u201d basis,\nWITHOUT WARRANTY OF ANY KIND, either express or implied. See the License for\nthe specific language governing rights and limitations under the License.\n\nThe Original Code is______________________.\n\nThe Original Developer is not the Initial Developer and is __________. If left\nblank, the Original Developer is the Initial Developer.\n\nThe Initial Developer of the Original Code is ____________. All portions of\nthe code written by ___________ are Copyright (c) _____. All Rights Reserved.\n\nContributor ______________________.\n\nAlternatively, the contents of this file may be used under the terms of the\n_____ license (the [___] License), in which case the provisions of [______]\nLicense are applicable instead of those above.\n\nIf you wish to allow use of your version of this file only under the terms of\nthe [____] License and not to allow others to use your version of this file\nunder the CPAL, indicate your decision by deleting the provisions above and\nreplace them with the notice and other provisions required by the [___]\nLicense. If you do not delete the provisions above, a recipient may use your\nversion of this file under either the CPAL or the [___] License.\u201d\n\n[NOTE: The text of this Exhibit A may differ slightly from the text of the\nnotices in the Source Code files of the Original Code. You should use the text\nof this Exhibit A rather than the text found in the Original Code Source Code\nfor Your Modifications.]\n\nEXHIBIT B. Attribution Information\n\nAttribution Copyright Notice: _______________________\n\nAttribution Phrase (not exceeding 10 words): _______________________\n\nAttribution URL: _______________________\n\nGraphic Image as provided in the Covered Code, if any.\n\nDisplay of Attribution Information is [required/not required] in Larger Works\nwhich are defined in the CPAL as a work which combines Covered Code or\nportions thereof with code not governed by the terms of the CPAL.\n\n" +
+	"", etag: `"ocHb6oIwobU="`})
@@ -0,0 +1,7 @@
+// AUTOMATICALLY GENERATED FILE. DO NOT EDIT.
+
+package assets
+
+var spdx_drl_1_0 = txt(asset{Name: "spdx_drl_1_0.txt", Content: "" +
+	"---\ntitle: Detection Rule License 1.0\nspdx-id: DRL-1.0\nsource: https://spdx.org/licenses/DRL-1.0.html\nosi-approved: false\nfsf-libre: false\n---\n\nDetection Rule License (DRL) 1.0\n\nPermission is hereby granted, free of charge, to any person obtaining a copy of this rule set and associated documentation files (the \"Rules\"), to deal in the Rules without restriction, including without limitation the rights to use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of the Rules, and to permit persons to whom the Rules are furnished to do so, subject to the following conditions:\n\nIf you share the Rules (including in modified form), you must retain the following if it is supplied within the Rules:\n\n1. identification of the authors(s) (\"author\" field) of the Rule and any others designated to receive attribution, in any reasonable manner requested by the Rule author (including by pseudonym if designated).\n\n2. a URI or hyperlink to the Rule set or explicit Rule to the extent reasonably practicable\n\n3. indicate the Rules are licensed under this Detection Rule License, and include the text of, or the URI or hyperlink to, this Detection Rule License to the extent reasonably practicable\n\nTHE RULES ARE PROVIDED \"AS IS\", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE RULES OR THE USE OR OTHER DEALINGS IN THE RULES.\n" +
+	"", etag: `"aec3gI8Uh4I="`})
@@ -0,0 +1,7 @@
+// AUTOMATICALLY GENERATED FILE. DO NOT EDIT.
+
+package assets
+
+var spdx_egenix = txt(asset{Name: "spdx_egenix.txt", Content: "" +
+	"---\ntitle: eGenix.com Public License 1.1.0\nspdx-id: eGenix\nsource: https://spdx.org/licenses/eGenix.html\nosi-approved: false\nfsf-libre: false\n---\n\nEGENIX.COM PUBLIC LICENSE AGREEMENT\nVersion 1.1.0\nThis license agreement is based on the Python CNRI License Agreement, a widely\naccepted open- source license.\n\n1. Introduction\nThis \"License Agreement\" is between eGenix.com Software, Skills and Services\nGmbH (\"eGenix.com\"), having an office at Pastor-Loeh-Str. 48, D-40764\nLangenfeld, Germany, and the Individual or Organization (\"Licensee\") accessing\nand otherwise using this software in source or binary form and its associated\ndocumentation (\"the Software\").\n\n2. License\nSubject to the terms and conditions of this eGenix.com Public License Agreement,\neGenix.com hereby grants Licensee a non-exclusive, royalty-free, world-wide\nlicense to reproduce, analyze, test, perform and/or display publicly, prepare\nderivative works, distribute, and otherwise use the Software alone or in any\nderivative version, provided, however, that the eGenix.com Public License\nAgreement is retained in the Software, or in any derivative version of the\nSoftware prepared by Licensee.\n\n3. NO WARRANTY\neGenix.com is making the Software available to Licensee on an \"AS IS\" basis.\nSUBJECT TO ANY STATUTORY WARRANTIES WHICH CAN NOT BE EXCLUDED, EGENIX.COM MAKES\nNO REPRESENTATIONS OR WARRANTIES, EXPRESS OR IMPLIED. BY WAY OF EXAMPLE, BUT NOT\nLIMITATION, EGENIX.COM MAKES NO AND DISCLAIMS ANY REPRESENTATION OR WARRANTY OF\nMERCHANTABILITY OR FITNESS FOR ANY PARTICULAR PURPOSE OR THAT THE USE OF THE\nSOFTWARE WILL NOT INFRINGE ANY THIRD PARTY RIGHTS.\n\n4. LIMITATION OF LIABILITY\nEGENIX.COM SHALL NOT BE LIABLE TO LICENSEE OR ANY OTHER USERS OF THE SOFTWARE\nFOR ANY INCIDENTAL, SPECIAL, OR CONSEQUENTIAL DAMAGES OR LOSS (INCLUDING,\nWITHOUT LIMITATION, DAMAGES FOR LOSS OF BUSINESS PROFITS, BUSINESS INTERRUPTION,\nLOSS OF BUSINESS INFORMATION, OR OTHER PECUNIARY LOSS) AS A RESULT OF USING,\nMODIFYING OR DISTRIBUTING THE SOFTWARE, OR ANY DERIVATIVE THEREOF, EVEN IF\nADVISED OF THE POSSIBILITY THEREOF. SOME JURISDICTIONS DO NOT ALLOW THE\nEXCLUSION OR LIMITATION OF INCIDENTAL OR CONSEQUENTIAL DAMAGES, SO THE ABOVE\nEXCLUSION OR LIMITATION MAY NOT APPLY TO LICENSEE.\n\n5. Termination\nThis License Agreement will automatically terminate upon a material breach of\nits terms and conditions.\n\n6. Third Party Rights\nAny software or documentation in source or binary form provided along with the\nSoftware that is associated with a separate license agreement is licensed to\nLicensee under the terms of that license agreement. This License Agreement does\nnot apply to those portions of the Software. Copies of the third party licenses\nare included in the Software Distribution.\n\n7. General\nNothing in this License Agreement affects any statutory rights of consumers that\ncannot be waived or limited by contract.\n\nNothing in this License Agreement shall be deemed to create any relationship of\nagency, partnership, or joint venture between eGenix.com and Licensee.\n\nIf any provision of this License Agreement shall be unlawful, void, or for any\nreason unenforceable, such provision shall be modified to the extent necessary\nto render it enforceable without losing its intent, or, if no such modification\nis possible, be severed from this License Agreement and shall not affect the\nvalidity and enforceability of the remaining provisions of this License\nAgreement.\n\nThis License Agreement shall be governed by and interpreted in all respects by\nthe law of Germany, excluding conflict of law provisions. It shall not be\ngoverned by the United Nations Convention on Contracts for International Sale of\nGoods. This License Agreement does not grant permission to use eGenix.com\ntrademarks or trade names in a trademark sense to endorse or promote products or\nservices of Licensee, or any third party.\n\nThe controlling language of this License Agreement is English. If Licensee has\nreceived a translation into another language, it has been provided for\nLicensee's convenience only.\n\n8. Agreement\nBy downloading, copying, installing or otherwise using the Software, Licensee\nagrees to be bound by the terms and conditions of this License Agreement. For\nquestion regarding this License Agreement, please write to:\neGenix.com Software, Skills and Services GmbH\nPastor-Loeh-Str. 48\nD-40764 Langenfeld\nGermany\n" +
+	"", etag: `"oamR7Wdrq+U="`})
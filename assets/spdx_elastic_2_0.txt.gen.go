@@ -0,0 +1,7 @@
+// AUTOMATICALLY GENERATED FILE. DO NOT EDIT.
+
+package assets
+
+var spdx_elastic_2_0 = txt(asset{Name: "spdx_elastic_2_0.txt", Content: "" +
+	"---\ntitle: Elastic License 2.0\nspdx-id: Elastic-2.0\nsource: https://spdx.org/licenses/Elastic-2.0.html\nosi-approved: false\nfsf-libre: false\n---\n\nElastic License 2.0\n\nURL: https://www.elastic.co/licensing/elastic-license\n\n## Acceptance\n\nBy using the software, you agree to all of the terms and conditions below.\n\n## Copyright License\n\nThe licensor grants you a non-exclusive, royalty-free, worldwide,\nnon-sublicensable, non-transferable license to use, copy, distribute, make\navailable, and prepare derivative works of the software, in each case subject to\nthe limitations and conditions below.\n\n## Limitations\n\nYou may not provide the software to third parties as a hosted or managed\nservice, where the service provides users with access to any substantial set of\nthe features or functionality of the software.\n\nYou may not move, change, disable, or circumvent the license key functionality\nin the software, and you may not remove or obscure any functionality in the\nsoftware that is protected by the license key.\n\nYou may not alter, remove, or obscure any licensing, copyright, or other notices\nof the licensor in the software. Any use of the licensor\u2019s trademarks is subject\nto applicable law.\n\n## Patents\n\nThe licensor grants you a license, under any patent claims the licensor can\nlicense, or becomes able to license, to make, have made, use, sell, offer for\nsale, import and have imported the software, in each case subject to the\nlimitations and conditions in this license. This license does not cover any\npatent claims that you cause to be infringed by modifications or additions to\nthe software. If you or your company make any written claim that the software\ninfringes or contributes to infringement of any patent, your patent license for\nthe software granted under these terms ends immediately. If your company makes\nsuch a claim, your patent license ends immediately for work on behalf of your\ncompany.\n\n## Notices\n\nYou must ensure that anyone who gets a copy of any part of the software from you\nalso gets a copy of these terms.\n\nIf you modify the software, you must include in any modified copies of the\nsoftware prominent notices stating that you have modified the software.\n\n## No Other Rights\n\nThese terms do not imply any licenses other than those expressly granted in\nthese terms.\n\n## Termination\n\nIf you use the software in violation of these terms, such use is not licensed,\nand your licenses will automatically terminate. If the licensor provides you\nwith a notice of your violation, and you cease all violation of this license no\nlater than 30 days after you receive that notice, your licenses will be\nreinstated retroactively. However, if you violate these terms after such\nreinstatement, any additional violation of these terms will cause your licenses\nto terminate automatically and permanently.\n\n## No Liability\n\n*As far as the law allows, the software comes as is, without any warranty or\ncondition, and the licensor will not be liable to you for any damages arising\nout of these terms or the use or nature of the software, under any kind of\nlegal claim.*\n\n## Definitions\n\nThe **licensor** is the entity offering these terms, and the **software** is the\nsoftware the licensor makes available under these terms, including any portion\nof it.\n\n**you** refers to the individual or entity agreeing to these terms.\n\n**your company** is any legal entity, sole proprietorship, or other kind of\norganization that you work for, plus all organizations that have control over,\nare under the control of, or are under common control with that\norganization. **control** means ownership of substantially all the assets of an\nentity, or the power to direct its management and policies by vote, contract, or\notherwise. Control can be direct or indirect.\n\n**your licenses** are all the licenses granted to you for the software under\nthese terms.\n\n**use** means anything you do with the software requiring one of your licenses.\n\n**trademark** means trademarks, service marks, and similar rights.\n" +
+	"", etag: `"MS4eVgy/4iM="`})
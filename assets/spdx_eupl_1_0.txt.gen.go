@@ -0,0 +1,7 @@
+// AUTOMATICALLY GENERATED FILE. DO NOT EDIT.
+
+package assets
+
+var spdx_eupl_1_0 = txt(asset{Name: "spdx_eupl_1_0.txt", Content: "" +
+	"---\ntitle: European Union Public License 1.0\nspdx-id: EUPL-1.0\nsource: https://spdx.org/licenses/EUPL-1.0.html\nosi-approved: false\nfsf-libre: false\n---\n\nEuropean Union Public Licence V.1.0\n\nEUPL (c) the European Community 2007\n\nThis European Union Public Licence (the \u201cEUPL\u201d) applies to the Work or\nSoftware (as defined below) which is provided under the terms of this Licence.\nAny use of the Work, other than as authorised under this Licence is prohibited\n(to the extent such use is covered by a right of the copyright holder of the\nWork).\n\nThe Original Work is provided under the terms of this Licence when the\nLicensor (as defined below) has placed the following notice immediately\nfollowing the copyright notice for the Original Work:\n\nLicensed under the EUPL V.1.0\n\nor has expressed by any other mean his willingness to license under the EUPL.\n\n1. Definitions \n\nIn this Licence, the following terms have the following meaning:\n\n\u2212 The Licence: this Licence.\n\n\u2212 The Original Work or the Software: the software distributed and/or\ncommunicated by the Licensor under this Licence, available as Source Code and\nalso as Executable Code as the case may be.\n\n\u2212 Derivative Works: the works or software that could be created by the\nLicensee, based upon the Original Work or modifications thereof. This Licence\ndoes not define the extent of modification or dependence on the Original Work\nrequired in order to classify a work as a Derivative Work; this extent is\ndetermined by copyright law applicable in the country mentioned in Article 15.\n\n\u2212 The Work: the Original Work and/or its Derivative Works.\n\n\u2212 The Source Code: the human-readable form of the Work which is the most\nconvenient for people to study and modify.\n\n\u2212 The Executable Code: any code which has generally been compiled and which is\nmeant to be interpreted by a computer as a program.\n\n\u2212 The Licensor: the natural or legal person that distributes and/or\ncommunicates the Work under the Licence.\n\n\u2212 Contributor(s): any natural or legal person who modifies the Work under the\nLicence, or otherwise contributes to the creation of a Derivative Work.\n\n\u2212 The Licensee or \u201cYou\u201d: any natural or legal person who makes any usage of\nthe Software under the terms of the Licence. \u2212 Distribution and/or\nCommunication: any act of selling, giving, lending, renting, distributing,\ncommunicating, transmitting, or otherwise making available, on-line or off-\nline, copies of the Work at the disposal of any other natural or legal person.\n\n2. Scope of the rights granted by the Licence \n\nThe Licensor hereby grants You a world-wide, royalty-free, non-exclusive, sub-\nlicensable licence to do the following, for the duration of copyright vested\nin the Original Work:\n\n\u2212 use the Work in any circumstance and for all usage,\n\n\u2212 reproduce the Work,\n\n\u2212 modify the Original Work, and make Derivative Works based upon the Work,\n\n\u2212 communicate to the public, including the right to make available or display\nthe Work or copies thereof to the public and perform publicly, as the case may\nbe, the Work,\n\n\u2212 distribute the Work or copies thereof,\n\n\u2212 lend and rent the Work or copies thereof,\n\n\u2212 sub-license rights in the Work or copies thereof.\n\nThose rights can be exercised on any media, supports and formats, whether now\nknown or later invented, as far as the applicable law permits so.\n\nIn the countries where moral rights apply, the Licensor waives his right to\nexercise his moral right to the extent allowed by law in order to make\neffective the licence of the economic rights here above listed.\n\nThe Licensor grants to the Licensee royalty-free, non exclusive usage rights\nto any patents held by the Licensor, to the extent necessary to make use of\nthe rights granted on the Work under this Licence.\n\n3. Communication of the Source Code \n\nThe Licensor may provide the Work either in its Source Code form, or as\nExecutable Code. If the Work is provided as Executable Code, the Licensor\nprovides in addition a machinereadable copy of the Source Code of the Work\nalong with each copy of the Work that the Licensor distributes or indicates,\nin a notice following the copyright notice attached to the Work, a repository\nwhere the Source Code is easily and freely accessible for as long as the\nLicensor continues to distribute and/or communicate the Work.\n\n4. Limitations on copyright \n\nNothing in this Licence is intended to deprive the Licensee of the benefits\nfrom any exception or limitation to the exclusive rights of the rights owners\nin the Original Work or Software, of the exhaustion of those rights or of\nother applicable limitations thereto.\n\n5. Obligations of the Licensee \n\nThe grant of the rights mentioned above is subject to some restrictions and\nobligations imposed on the Licensee. Those obligations are the following:\n\nAttribution right: the Licensee shall keep intact all copyright, patent or\ntrademarks notices and all notices that refer to the Licence and to the\ndisclaimer of warranties. The Licensee must include a copy of such notices and\na copy of the Licence with every copy of the Work he/she distributes and/or\ncommunicates. The Licensee must cause any Derivative Work to carry prominent\nnotices stating that the Work has been modified and the date of modification.\n\nCopyleft clause: If the Licensee distributes and/or communicates copies of the\nOriginal Works or Derivative Works based upon the Original Work, this\nDistribution and/or Communication will be done under the terms of this\nLicence. The Licensee (becoming Licensor) cannot offer or impose any\nadditional terms or conditions on the Work or Derivative Work that alter or\nrestrict the terms of the Licence.\n\nCompatibility clause: If the Licensee Distributes and/or Communicates\nDerivative Works or copies thereof based upon both the Original Work and\nanother work licensed under a Compatible Licence, this Distribution and/or\nCommunication can be done under the terms of this Compatible Licence. For the\nsake of this clause, \u201cCompatible Licence\u201d refers to the licences listed in the\nappendix attached to this Licence. Should the Licensee\u2019s obligations under the\nCompatible Licence conflict with his/her obligations under this Licence, the\nobligations of the Compatible Licence shall prevail.\n\nProvision of Source Code: When distributing and/or communicating copies of the\nWork, the Licensee will provide a machine-readable copy of the Source Code or\nindicate a repository where this Source will be easily and freely available\nfor as long as the Licensee continues to distribute and/or communicate the\nWork.\n\nLegal Protection: This Licence does not grant permission to use the trade\nnames, trademarks, service marks, or names of the Licensor, except as required\nfor reasonable and customary use in describing the origin of the Work and\nreproducing the content of the copyright notice.\n\n6. Chain of Authorship \n\nThe original Licensor warrants that the copyright in the Original Work granted\nhereunder is owned by him/her or licensed to him/her and that he/she has the\npower and authority to grant the Licence.\n\nEach Contributor warrants that the copyright in the modifications he/she\nbrings to the Work are owned by him/her or licensed to him/her and that he/she\nhas the power and authority to grant the Licence.\n\nEach time You, as a Licensee, receive the Work, the original Licensor and\nsubsequent Contributors grant You a licence to their contributions to the\nWork, under the terms of this Licence.\n\n7. Disclaimer of Warranty \n\nThe Work is a work in progress, which is continuously improved by numerous\ncontributors. It is not a finished work and may therefore contain defects or\n\u201cbugs\u201d inherent to this type of software development.\n\nFor the above reason, the Work is provided under the Licence on an \u201cas is\u201d\nbasis and without warranties of any kind concerning the Work, including\nwithout limitation merchantability, fitness for a particular purpose, absence\nof defects or errors, accuracy, non-infringement of intellectual property\nrights other than copyright as stated in Article 6 of this Licence.\n\nThis disclaimer of warranty is an essential part of the Licence and a\ncondition for the grant of any rights to the Work.\n\n8. Disclaimer of Liability \n\nExcept in the cases of wilful misconduct or damages directly caused to natural\npersons, the Licensor will in no event be liable for any direct or indirect,\nmaterial or moral, damages of any kind, arising out of the Licence or of the\nuse of the Work, including without limitation, damages for loss of goodwill,\nwork stoppage, computer failure or malfunction, loss of data or any commercial\ndamage, even if the Licensor has been advised of the possibility of such\ndamage. However, the Licensor will be liable under statutory product liability\nlaws as far such laws apply to the Work.\n\n9. Additional agreements \n\nWhile distributing the Original Work or Derivative Works, You may choose to\nconclude an additional agreement to offer, and charge a fee for, acceptance of\nsupport, warranty, indemnity, or other liability obligations and/or services\nconsistent with this Licence. However, in accepting such obligations, You may\nact only on your own behalf and on your sole responsibility, not on behalf of\nthe original Licensor or any other Contributor, and only if You agree to\nindemnify, defend, and hold each Contributor harmless for any liability\nincurred by, or claims asserted against such Contributor by the fact You have\naccepted any such warranty or additional liability.\n\n10. Acceptance of the Licence \n\nThe provisions of this Licence can be accepted by clicking on an icon \u201cI\nagree\u201d placed under the bottom of a window displaying the text of this Licence\nor by affirming consent in any other similar way, in accordance with the rules\nof applicable law. Clicking on that icon indicates your clear and irrevocable\nacceptance of this Licence and all of its terms and conditions.\n\nSimilarly, you irrevocably accept this Licence and all of its terms and\nconditions by exercising any rights granted to You by Article 2 of this\nLicence, such as the use of the Work, the creation by You of a Derivative Work\nor the Distribution and/or Communication by You of the Work or copies thereof.\n\n11. Information to the public \n\nIn case of any Distribution and/or Communication of the Work by means of\nelectronic communication by You (for example, by offering to download the Work\nfrom a remote location) the distribution channel or media (for example, a\nwebsite) must at least provide to the public the information requested by the\napplicable law regarding the identification and address of the Licensor, the\nLicence and the way it may be accessible, concluded, stored and reproduced by\nthe Licensee.\n\n12. Termination of the Licence \n\nThe Licence and the rights granted hereunder will terminate automatically upon\nany breach by the Licensee of the terms of the Licence.\n\nSuch a termination will not terminate the licences of any person who has\nreceived the Work from the Licensee under the Licence, provided such persons\nremain in full compliance with the Licence.\n\n13. Miscellaneous \n\nWithout prejudice of Article 9 above, the Licence represents the complete\nagreement between the Parties as to the Work licensed hereunder.\n\nIf any provision of the Licence is invalid or unenforceable under applicable\nlaw, this will not affect the validity or enforceability of the Licence as a\nwhole. Such provision will be construed and/or reformed so as necessary to\nmake it valid and enforceable.\n\nThe European Commission may put into force translations and/or binding new\nversions of this Licence, so far this is required and reasonable. New versions\nof the Licence will be published with a unique version number. The new version\nof the Licence becomes binding for You as soon as You become aware of its\npublication.\n\n14. Jurisdiction \n\nAny litigation resulting from the interpretation of this License, arising\nbetween the European Commission, as a Licensor, and any Licensee, will be\nsubject to the jurisdiction of the Court of Justice of the European\nCommunities, as laid down in article 238 of the Treaty establishing the\nEuropean Community.\n\nAny litigation arising between Parties, other than the European Commission,\nand resulting from the interpretation of this License, will be subject to the\nexclusive jurisdiction of the competent court where the Licensor resides or\nconducts its primary business.\n\n15. Applicable Law \n\nThis Licence shall be governed by the law of the European Union country where\nthe Licensor resides or has his registered office.\n\nThis licence shall be governed by the Belgian law if:\n\n\u2212 a litigation arises between the European Commission, as a Licensor, and any\nLicensee;\n\n\u2212 the Licensor, other than the European Commission, has no residence or\nregistered office inside a European Union country.\n\n\nAppendix\n\n\u201cCompatible Licences\u201d according to article 5 EUPL are:\n\n\u2212 General Public License (GPL) v. 2\n\n\u2212 Open Software License (OSL) v. 2.1, v. 3.0\n\n\u2212 Common Public License v. 1.0\n\n\u2212 Eclipse Public License v. 1.0\n\n\u2212 Cecill v. 2.0\n\n" +
+	"", etag: `"STLCT8O/q5k="`})
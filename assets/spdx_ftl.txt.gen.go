@@ -0,0 +1,7 @@
+// AUTOMATICALLY GENERATED FILE. DO NOT EDIT.
+
+package assets
+
+var spdx_ftl = txt(asset{Name: "spdx_ftl.txt", Content: "" +
+	"---\ntitle: Freetype Project License\nspdx-id: FTL\nsource: https://spdx.org/licenses/FTL.html\nosi-approved: false\nfsf-libre: false\n---\n\nThe FreeType Project LICENSE\n\n2006-Jan-27\n\nCopyright 1996-2002, 2006 by David Turner, Robert Wilhelm, and Werner Lemberg\n\nIntroduction\n\nThe FreeType Project is distributed in several archive packages; some of them\nmay contain, in addition to the FreeType font engine, various tools and\ncontributions which rely on, or relate to, the FreeType Project.\n\nThis license applies to all files found in such packages, and which do not\nfall under their own explicit license. The license affects thus the FreeType\nfont engine, the test programs, documentation and makefiles, at the very\nleast.\n\nThis license was inspired by the BSD, Artistic, and IJG (Independent JPEG\nGroup) licenses, which all encourage inclusion and use of free software in\ncommercial and freeware products alike. As a consequence, its main points are\nthat:\n\no We don&apos;t promise that this software works. However, we will be\ninterested in any kind of bug reports. (`as is&apos; distribution)\n\no You can use this software for whatever you want, in parts or full form,\nwithout having to pay us. (`royalty-free&apos; usage)\n\no You may not pretend that you wrote this software. If you use it, or only\nparts of it, in a program, you must acknowledge somewhere in your\ndocumentation that you have used the FreeType code. (`credits&apos;)\n\nWe specifically permit and encourage the inclusion of this software, with or\nwithout modifications, in commercial products. We disclaim all warranties\ncovering The FreeType Project and assume no liability related to The FreeType\nProject.\n\nFinally, many people asked us for a preferred form for a credit/disclaimer to\nuse in compliance with this license. We thus encourage you to use the\nfollowing text:\n\n\"\"\" Portions of this software are copyright \u00a9 <year> The FreeType Project\n(www.freetype.org). All rights reserved. \"\"\"\n\nPlease replace <year> with the value from the FreeType version you actually\nuse.\n\nLegal Terms\n\n0. Definitions \n\nThroughout this license, the terms `package&apos;, `FreeType Project&apos;,\nand `FreeType archive&apos; refer to the set of files originally distributed\nby the authors (David Turner, Robert Wilhelm, and Werner Lemberg) as the\n`FreeType Project&apos;, be they named as alpha, beta or final release.\n\n`You&apos; refers to the licensee, or person using the project, where\n`using&apos; is a generic term including compiling the project&apos;s source\ncode as well as linking it to form a `program&apos; or `executable&apos;. This\nprogram is referred to as `a program using the FreeType engine&apos;.\n\nThis license applies to all files distributed in the original FreeType\nProject, including all source code, binaries and documentation, unless\notherwise stated in the file in its original, unmodified form as distributed\nin the original archive. If you are unsure whether or not a particular file is\ncovered by this license, you must contact us to verify this.\n\nThe FreeType Project is copyright (C) 1996-2000 by David Turner, Robert\nWilhelm, and Werner Lemberg. All rights reserved except as specified below.\n\n1. No Warranty \n\nTHE FREETYPE PROJECT IS PROVIDED `AS IS&apos; WITHOUT WARRANTY OF ANY KIND,\nEITHER EXPRESS OR IMPLIED, INCLUDING, BUT NOT LIMITED TO, WARRANTIES OF\nMERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE. IN NO EVENT WILL ANY OF\nTHE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY DAMAGES CAUSED BY THE USE\nOR THE INABILITY TO USE, OF THE FREETYPE PROJECT.\n\n2. Redistribution \n\nThis license grants a worldwide, royalty-free, perpetual and irrevocable right\nand license to use, execute, perform, compile, display, copy, create\nderivative works of, distribute and sublicense the FreeType Project (in both\nsource and object code forms) and derivative works thereof for any purpose;\nand to authorize others to exercise some or all of the rights granted herein,\nsubject to the following conditions:\n\no Redistribution of source code must retain this license file (`FTL.TXT&apos;)\nunaltered; any additions, deletions or changes to the original files must be\nclearly indicated in accompanying documentation. The copyright notices of the\nunaltered, original files must be preserved in all copies of source files.\n\no Redistribution in binary form must provide a disclaimer that states that the\nsoftware is based in part of the work of the FreeType Team, in the\ndistribution documentation. We also encourage you to put an URL to the\nFreeType web page in your documentation, though this isn&apos;t mandatory.\n\nThese conditions apply to any software derived from or based on the FreeType\nProject, not just the unmodified files. If you use our work, you must\nacknowledge us. However, no fee need be paid to us.\n\n3. Advertising \n\nNeither the FreeType authors and contributors nor you shall use the name of\nthe other for commercial, advertising, or promotional purposes without\nspecific prior written permission.\n\nWe suggest, but do not require, that you use one or more of the following\nphrases to refer to this software in your documentation or advertising\nmaterials: `FreeType Project&apos;, `FreeType Engine&apos;, `FreeType\nlibrary&apos;, or `FreeType Distribution&apos;.\n\nAs you have not signed this license, you are not required to accept it.\nHowever, as the FreeType Project is copyrighted material, only this license,\nor another one contracted with the authors, grants you the right to use,\ndistribute, and modify it. Therefore, by using, distributing, or modifying the\nFreeType Project, you indicate that you understand and accept all the terms of\nthis license.\n\n4. Contacts \n\nThere are two mailing lists related to FreeType:\n\no freetype@nongnu.org\n\nDiscusses general use and applications of FreeType, as well as future and\nwanted additions to the library and distribution. If you are looking for\nsupport, start in this list if you haven&apos;t found anything to help you in\nthe documentation.\n\no freetype-devel@nongnu.org\n\nDiscusses bugs, as well as engine internals, design issues, specific licenses,\nporting, etc.\n\nOur home page can be found at\n\nhttp://www.freetype.org\n\n--- end of FTL.TXT --- \n\n" +
+	"", etag: `"v8mBZmGdLrM="`})
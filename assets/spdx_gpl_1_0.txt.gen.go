@@ -0,0 +1,7 @@
+// AUTOMATICALLY GENERATED FILE. DO NOT EDIT.
+
+package assets
+
+var spdx_gpl_1_0 = txt(asset{Name: "spdx_gpl_1_0.txt", Content: "" +
+	"---\ntitle: GNU General Public License v1.0\nspdx-id: GPL-1.0\nsource: https://spdx.org/licenses/GPL-1.0-only.html\nosi-approved: false\nfsf-libre: false\n---\n\n                    GNU GENERAL PUBLIC LICENSE\n                     Version 1, February 1989\n\n Copyright (C) 1989 Free Software Foundation, Inc.\n                    51 Franklin St, Fifth Floor, Boston, MA  02110-1301  USA\n\n Everyone is permitted to copy and distribute verbatim copies\n of this license document, but changing it is not allowed.\n\n                            Preamble\n\n  The license agreements of most software companies try to keep users\nat the mercy of those companies.  By contrast, our General Public\nLicense is intended to guarantee your freedom to share and change free\nsoftware--to make sure the software is free for all its users.  The\nGeneral Public License applies to the Free Software Foundation's\nsoftware and to any other program whose authors commit to using it.\nYou can use it for your programs, too.\n\n  When we speak of free software, we are referring to freedom, not\nprice.  Specifically, the General Public License is designed to make\nsure that you have the freedom to give away or sell copies of free\nsoftware, that you receive source code or can get it if you want it,\nthat you can change the software or use pieces of it in new free\nprograms; and that you know you can do these things.\n\n  To protect your rights, we need to make restrictions that forbid\nanyone to deny you these rights or to ask you to surrender the rights.\nThese restrictions translate to certain responsibilities for you if you\ndistribute copies of the software, or if you modify it.\n\n  For example, if you distribute copies of a such a program, whether\ngratis or for a fee, you must give the recipients all the rights that\nyou have.  You must make sure that they, too, receive or can get the\nsource code.  And you must tell them their rights.\n\n  We protect your rights with two steps: (1) copyright the software, and\n(2) offer you this license which gives you legal permission to copy,\ndistribute and/or modify the software.\n\n  Also, for each author's protection and ours, we want to make certain\nthat everyone understands that there is no warranty for this free\nsoftware.  If the software is modified by someone else and passed on, we\nwant its recipients to know that what they have is not the original, so\nthat any problems introduced by others will not reflect on the original\nauthors' reputations.\n\n  The precise terms and conditions for copying, distribution and\nmodification follow.\n\n                    GNU GENERAL PUBLIC LICENSE\n   TERMS AND CONDITIONS FOR COPYING, DISTRIBUTION AND MODIFICATION\n\n  0. This License Agreement applies to any program or other work which\ncontains a notice placed by the copyright holder saying it may be\ndistributed under the terms of this General Public License.  The\n\"Program\", below, refers to any such program or work, and a \"work based\non the Program\" means either the Program or any work containing the\nProgram or a portion of it, either verbatim or with modifications.  Each\nlicensee is addressed as \"you\".\n\n  1. You may copy and distribute verbatim copies of the Program's source\ncode as you receive it, in any medium, provided that you conspicuously and\nappropriately publish on each copy an appropriate copyright notice and\ndisclaimer of warranty; keep intact all the notices that refer to this\nGeneral Public License and to the absence of any warranty; and give any\nother recipients of the Program a copy of this General Public License\nalong with the Program.  You may charge a fee for the physical act of\ntransferring a copy.\n\n  2. You may modify your copy or copies of the Program or any portion of\nit, and copy and distribute such modifications under the terms of Paragraph\n1 above, provided that you also do the following:\n\n    a) cause the modified files to carry prominent notices stating that\n    you changed the files and the date of any change; and\n\n    b) cause the whole of any work that you distribute or publish, that\n    in whole or in part contains the Program or any part thereof, either\n    with or without modifications, to be licensed at no charge to all\n    third parties under the terms of this General Public License (except\n    that you may choose to grant warranty protection to some or all\n    third parties, at your option).\n\n    c) If the modified program normally reads commands interactively when\n    run, you must cause it, when started running for such interactive use\n    in the simplest and most usual way, to print or display an\n    announcement including an appropriate copyright notice and a notice\n    that there is no warranty (or else, saying that you provide a\n    warranty) and that users may redistribute the program under these\n    conditions, and telling the user how to view a copy of this General\n    Public License.\n\n    d) You may charge a fee for the physical act of transferring a\n    copy, and you may at your option offer warranty protection in\n    exchange for a fee.\n\nMere aggregation of another independent work with the Program (or its\nderivative) on a volume of a storage or distribution medium does not bring\nthe other work under the scope of these terms.\n\n  3. You may copy and distribute the Program (or a portion or derivative of\nit, under Paragraph 2) in object code or executable form under the terms of\nParagraphs 1 and 2 above provided that you also do one of the following:\n\n    a) accompany it with the complete corresponding machine-readable\n    source code, which must be distributed under the terms of\n    Paragraphs 1 and 2 above; or,\n\n    b) accompany it with a written offer, valid for at least three\n    years, to give any third party free (except for a nominal charge\n    for the cost of distribution) a complete machine-readable copy of the\n    corresponding source code, to be distributed under the terms of\n    Paragraphs 1 and 2 above; or,\n\n    c) accompany it with the information you received as to where the\n    corresponding source code may be obtained.  (This alternative is\n    allowed only for noncommercial distribution and only if you\n    received the program in object code or executable form alone.)\n\nSource code for a work means the preferred form of the work for making\nmodifications to it.  For an executable file, complete source code means\nall the source code for all modules it contains; but, as a special\nexception, it need not include source code for modules which are standard\nlibraries that accompany the operating system on which the executable\nfile runs, or for standard header files or definitions files that\naccompany that operating system.\n\n  4. You may not copy, modify, sublicense, distribute or transfer the\nProgram except as expressly provided under this General Public License.\nAny attempt otherwise to copy, modify, sublicense, distribute or transfer\nthe Program is void, and will automatically terminate your rights to use\nthe Program under this License.  However, parties who have received\ncopies, or rights to use copies, from you under this General Public\nLicense will not have their licenses terminated so long as such parties\nremain in full compliance.\n\n  5. By copying, distributing or modifying the Program (or any work based\non the Program) you indicate your acceptance of this license to do so,\nand all its terms and conditions.\n\n  6. Each time you redistribute the Program (or any work based on the\nProgram), the recipient automatically receives a license from the original\nlicensor to copy, distribute or modify the Program subject to these\nterms and conditions.  You may not impose any further restrictions on the\nrecipients' exercise of the rights granted herein.\n\n  7. The Free Software Foundation may publish revised and/or new versions\nof the General Public License from time to time.  Such new versions will\nbe similar in spirit to the present version, but may differ in detail to\naddress new problems or concerns.\n\nEach version is given a distinguishing version number.  If the Program\nspecifies a version number of the license which applies to it and \"any\nlater version\", you have the option of following the terms and conditions\neither of that version or of any later version published by the Free\nSoftware Foundation.  If the Program does not specify a version number of\nthe license, you may choose any version ever published by the Free Software\nFoundation.\n\n  8. If you wish to incorporate parts of the Program into other free\nprograms whose distribution conditions are different, write to the author\nto ask for permission.  For software which is copyrighted by the Free\nSoftware Foundation, write to the Free Software Foundation; we sometimes\nmake exceptions for this.  Our decision will be guided by the two goals\nof preserving the free status of all derivatives of our free software and\nof promoting the sharing and reuse of software generally.\n\n                            NO WARRANTY\n\n  9. BECAUSE THE PROGRAM IS LICENSED FREE OF CHARGE, THERE IS NO WARRANTY\nFOR THE PROGRAM, TO THE EXTENT PERMITTED BY APPLICABLE LAW.  EXCEPT WHEN\nOTHERWISE STATED IN WRITING THE COPYRIGHT HOLDERS AND/OR OTHER PARTIES\nPROVIDE THE PROGRAM \"AS IS\" WITHOUT WARRANTY OF ANY KIND, EITHER EXPRESSED\nOR IMPLIED, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF\nMERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE.  THE ENTIRE RISK AS\nTO THE QUALITY AND PERFORMANCE OF THE PROGRAM IS WITH YOU.  SHOULD THE\nPROGRAM PROVE DEFECTIVE, YOU ASSUME THE COST OF ALL NECESSARY SERVICING,\nREPAIR OR CORRECTION.\n\n  10. IN NO EVENT UNLESS REQUIRED BY APPLICABLE LAW OR AGREED TO IN WRITING\nWILL ANY COPYRIGHT HOLDER, OR ANY OTHER PARTY WHO MAY MODIFY AND/OR\nREDISTRIBUTE THE PROGRAM AS PERMITTED ABOVE, BE LIABLE TO YOU FOR DAMAGES,\nINCLUDING ANY GENERAL, SPECIAL, INCIDENTAL OR CONSEQUENTIAL DAMAGES ARISING\nOUT OF THE USE OR INABILITY TO USE THE PROGRAM (INCLUDING BUT NOT LIMITED\nTO LOSS OF DATA OR DATA BEING RENDERED INACCURATE OR LOSSES SUSTAINED BY\nYOU OR THIRD PARTIES OR A FAILURE OF THE PROGRAM TO OPERATE WITH ANY OTHER\nPROGRAMS), EVEN IF SUCH HOLDER OR OTHER PARTY HAS BEEN ADVISED OF THE\nPOSSIBILITY OF SUCH DAMAGES.\n\n                     END OF TERMS AND CONDITIONS\n" +
+	"", etag: `"Amb+nhKeaiM="`})
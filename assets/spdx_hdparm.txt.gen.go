@@ -0,0 +1,7 @@
+// AUTOMATICALLY GENERATED FILE. DO NOT EDIT.
+
+package assets
+
+var spdx_hdparm = txt(asset{Name: "spdx_hdparm.txt", Content: "" +
+	"---\ntitle: hdparm License\nspdx-id: hdparm\nsource: https://spdx.org/licenses/hdparm.html\nosi-approved: false\nfsf-libre: false\n---\n\nBSD-Style Open Source License:\n\nYou may freely use, modify, and redistribute the hdparm program,\nas either binary or source, or both.\n\nThe only condition is that my name and copyright notice\nremain in the source code as-is.\n\nThe apt.c file is  Copyright (c) 2009 Jan Friesse <jfriesse@gmail.com>.\nYou may use/distribute apt.c freely, under the terms of either\n(your choice) the GNU General Public License version 2,\n or a BSD style license.\n\n\nMark Lord (mlord@pobox.com)\n" +
+	"", etag: `"GBdl89Cp3G4="`})
@@ -0,0 +1,7 @@
+// AUTOMATICALLY GENERATED FILE. DO NOT EDIT.
+
+package assets
+
+var spdx_ijg = txt(asset{Name: "spdx_ijg.txt", Content: "" +
+	"---\ntitle: Independent JPEG Group License\nspdx-id: IJG\nsource: https://spdx.org/licenses/IJG.html\nosi-approved: false\nfsf-libre: false\n---\n\nThis file is part of the Independent JPEG Group's software.\n\nThe authors make NO WARRANTY or representation, either express or implied,\nwith respect to this software, its quality, accuracy, merchantability, or\nfitness for a particular purpose.  This software is provided \"AS IS\", and\nyou, its user, assume the entire risk as to its quality and accuracy.\n\nThis software is copyright (C) 1994-1996, Thomas G. Lane.\nAll Rights Reserved except as specified below.\n\nPermission is hereby granted to use, copy, modify, and distribute this\nsoftware (or portions thereof) for any purpose, without fee, subject to\nthese conditions:\n(1) If any part of the source code for this software is distributed, then\nthis README file must be included, with this copyright and no-warranty\nnotice unaltered; and any additions, deletions, or changes to the original\nfiles must be clearly indicated in accompanying documentation.\n(2) If only executable code is distributed, then the accompanying\ndocumentation must state that \"this software is based in part on the work\nof the Independent JPEG Group\".\n(3) Permission for use of this software is granted only if the user accepts\nfull responsibility for any undesirable consequences; the authors accept\nNO LIABILITY for damages of any kind.\n\nThese conditions apply to any software derived from or based on the IJG\ncode, not just to the unmodified library.  If you use our work, you ought\nto acknowledge us.\n\nPermission is NOT granted for the use of any IJG author's name or company\nname in advertising or publicity relating to this software or products\nderived from it.  This software may be referred to only as \"the Independent\nJPEG Group's software\".\n\nWe specifically permit and encourage the use of this software as the basis\nof commercial products, provided that all warranty or liability claims are\nassumed by the product vendor.\n" +
+	"", etag: `"GH7jkKvX13g="`})
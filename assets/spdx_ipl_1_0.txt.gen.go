@@ -0,0 +1,7 @@
+// AUTOMATICALLY GENERATED FILE. DO NOT EDIT.
+
+package assets
+
+var spdx_ipl_1_0 = txt(asset{Name: "spdx_ipl_1_0.txt", Content: "" +
+	"---\ntitle: IBM Public License v1.0\nspdx-id: IPL-1.0\nsource: https://spdx.org/licenses/IPL-1.0.html\nosi-approved: true\nfsf-libre: false\n---\n\nIBM Public License Version 1.0\n\nTHE ACCOMPANYING PROGRAM IS PROVIDED UNDER THE TERMS OF THIS IBM\n\nPUBLIC LICENSE (\"AGREEMENT\"). ANY USE, REPRODUCTION OR DISTRIBUTION\n\nOF THE PROGRAM CONSTITUTES RECIPIENT&apos;S ACCEPTANCE OF THIS AGREEMENT.\n\n1. DEFINITIONS   \n\"Contribution\" means:\n\na. in the case of International Business Machines Corporation (\"IBM\"), the\nOriginal Program, and\n\nb. in the case of each Contributor,\n\ni. changes to the Program, and\n\nii. additions to the Program;\n\nwhere such changes and/or additions to the Program originate from and\n\nare distributed by that particular Contributor. A Contribution\n\n&apos;originates&apos; from a Contributor if it was added to the Program by\n\nsuch Contributor itself or anyone acting on such Contributor&apos;s\n\nbehalf. Contributions do not include additions to the Program which:\n\n(i) are separate modules of software distributed in conjunction with\n\nthe Program under their own license agreement, and (ii) are not\n\nderivative works of the Program.\n\n\"Contributor\" means IBM and any other entity that distributes the Program.\n\n\"Licensed Patents \" mean patent claims licensable by a\n\nContributor which are necessarily infringed by the use or sale of its\n\nContribution alone or when combined with the Program.\n\n\"Original Program\" means the original version of the software\n\naccompanying this Agreement as released by IBM, including source\n\ncode, object code and documentation, if any.\n\n\"Program\" means the Original Program and Contributions.\n\n\"Recipient\" means anyone who receives the Program under this\n\nAgreement, including all Contributors.\n\n2. GRANT OF RIGHTS   \na. Subject to the terms of this Agreement, each Contributor hereby\n\ngrants Recipient a non-exclusive, worldwide, royalty-free copyright\n\nlicense to reproduce, prepare derivative works of, publicly display,\n\npublicly perform, distribute and sublicense the Contribution of such\n\nContributor, if any, and such derivative works, in source code and\n\nobject code form.\n\nb. Subject to the terms of this Agreement, each Contributor hereby\n\ngrants Recipient a non-exclusive, worldwide, royalty-free patent\n\nlicense under Licensed Patents to make, use, sell, offer to sell,\n\nimport and otherwise transfer the Contribution of such Contributor,\n\nif any, in source code and object code form. This patent license\n\nshall apply to the combination of the Contribution and the Program\n\nif, at the time the Contribution is added by the Contributor, such\n\naddition of the Contribution causes such combination to be covered by\n\nthe Licensed Patents. The patent license shall not apply to any\n\nother combinations which include the Contribution. No hardware per\n\nse is licensed hereunder.\n\nc. Recipient understands that although each Contributor grants the\n\nlicenses to its Contributions set forth herein, no assurances are\n\nprovided by any Contributor that the Program does not infringe the\n\npatent or other intellectual property rights of any other entity.\n\nEach Contributor disclaims any liability to Recipient for claims\n\nbrought by any other entity based on infringement of intellectual\n\nproperty rights or otherwise. As a condition to exercising the\n\nrights and licenses granted hereunder, each Recipient hereby assumes\n\nsole responsibility to secure any other intellectual property rights\n\nneeded, if any. For example, if a third party patent license is\n\nrequired to allow Recipient to distribute the Program, it is\n\nRecipient&apos;s responsibility to acquire that license before\n\ndistributing the Program.\n\nd. Each Contributor represents that to its knowledge it has\n\nsufficient copyright rights in its Contribution, if any, to grant the\n\ncopyright license set forth in this Agreement.\n\n3. REQUIREMENTS   \nA Contributor may choose to distribute\n\nthe Program in object code form under its own license agreement,\n\nprovided that:\n\na. it complies with the terms and conditions of this Agreement; and\n\nb. its license agreement:\n\ni. effectively disclaims on behalf of all Contributors all warranties\n\nand conditions, express and implied, including warranties or\n\nconditions of title and non-infringement, and implied warranties or\n\nconditions of merchantability and fitness for a particular purpose;\n\nii. effectively excludes on behalf of all Contributors all liability\n\nfor damages, including direct, indirect, special, incidental and\n\nconsequential damages, such as lost profits;\n\niii. states that any provisions which differ from this Agreement are\n\noffered by that Contributor alone and not by any other party; and\n\niv. states that source code for the Program is available from such\n\nContributor, and informs licensees how to obtain it in a reasonable\n\nmanner on or through a medium customarily used for software exchange.\n\nWhen the Program is made available in source code form:\n\na. it must be made available under this Agreement; and\n\nb. a copy of this Agreement must be included with each copy of the\n\nProgram.\n\nEach Contributor must include the following in a conspicuous location in the\nProgram:\n\nCopyright (C) 1996, 1999 International Business Machines Corporation and\nothers. All Rights Reserved.\n\nIn addition, each Contributor must identify itself as the originator\n\nof its Contribution, if any, in a manner that reasonably allows\n\nsubsequent Recipients to identify the originator of the Contribution.\n\n4. COMMERCIAL DISTRIBUTION   \nCommercial distributors of software may accept certain\n\nresponsibilities with respect to end users, business partners and the\n\nlike. While this license is intended to facilitate the commercial\n\nuse of the Program, the Contributor who includes the Program in a\n\ncommercial product offering should do so in a manner which does not\n\ncreate potential liability for other Contributors. Therefore, if a\n\nContributor includes the Program in a commercial product offering,\n\nsuch Contributor (\"Commercial Contributor\") hereby agrees to defend\n\nand indemnify every other Contributor (\"Indemnified Contributor\")\n\nagainst any losses, damages and costs (collectively \"Losses\") arising\n\nfrom claims, lawsuits and other legal actions brought by a third\n\nparty against the Indemnified Contributor to the extent caused by the\n\nacts or omissions of such Commercial Contributor in connection with\n\nits distribution of the Program in a commercial product offering.\n\nThe obligations in this section do not apply to any claims or Losses\n\nrelating to any actual or alleged intellectual property infringement.\n\nIn order to qualify, an Indemnified Contributor must: a) promptly\n\nnotify the Commercial Contributor in writing of such claim, and b)\n\nallow the Commercial Contributor to control, and cooperate with the\n\nCommercial Contributor in, the defense and any related settlement\n\nnegotiations. The Indemnified Contributor may participate in any\n\nsuch claim at its own expense.\n\nFor example, a Contributor might include the Program in a commercial\n\nproduct offering, Product X. That Contributor is then a Commercial\n\nContributor. If that Commercial Contributor then makes performance\n\nclaims, or offers warranties related to Product X, those performance\n\nclaims and warranties are such Commercial Contributor&apos;s\n\nresponsibility alone. Under this section, the Commercial Contributor\n\nwould have to defend claims against the other Contributors related to\n\nthose performance claims and warranties, and if a court requires any\n\nother Contributor to pay any damages as a result, the Commercial\n\nContributor must pay those damages.\n\n5. NO WARRANTY   \nEXCEPT AS EXPRESSLY SET FORTH IN THIS AGREEMENT, THE PROGRAM IS\n\nPROVIDED ON AN \"AS IS\" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY\n\nKIND, EITHER EXPRESS OR IMPLIED INCLUDING, WITHOUT LIMITATION, ANY\n\nWARRANTIES OR CONDITIONS OF TITLE, NON-INFRINGEMENT, MERCHANTABILITY\n\nOR FITNESS FOR A PARTICULAR PURPOSE. Each Recipient is solely\n\nresponsible for determining the appropriateness of using and\n\ndistributing the Program and assumes all risks associated with its\n\nexercise of rights under this Agreement, including but not limited to\n\nthe risks and costs of program errors, compliance with applicable\n\nlaws, damage to or loss of data, programs or equipment, and\n\nunavailability or interruption of operations.\n\n6. DISCLAIMER OF LIABILITY   \nEXCEPT AS EXPRESSLY SET FORTH IN THIS AGREEMENT, NEITHER RECIPIENT\n\nNOR ANY CONTRIBUTORS SHALL HAVE ANY LIABILITY FOR ANY DIRECT,\n\nINDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES\n\n(INCLUDING WITHOUT LIMITATION LOST PROFITS), HOWEVER CAUSED AND ON\n\nANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR\n\nTORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF\n\nTHE USE OR DISTRIBUTION OF THE PROGRAM OR THE EXERCISE OF ANY RIGHTS\n\nGRANTED HEREUNDER, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGES.\n\n7. GENERAL   \nIf any provision of this Agreement is invalid or unenforceable under\n\napplicable law, it shall not affect the validity or enforceability of\n\nthe remainder of the terms of this Agreement, and without further\n\naction by the parties hereto, such provision shall be reformed to the\n\nminimum extent necessary to make such provision valid and enforceable.\n\nIf Recipient institutes patent litigation against a Contributor with\n\nrespect to a patent applicable to software (including a cross-claim\n\nor counterclaim in a lawsuit), then any patent licenses granted by\n\nthat Contributor to such Recipient under this Agreement shall\n\nterminate as of the date such litigation is filed. In addition, if\n\nRecipient institutes patent litigation against any entity (including\n\na cross-claim or counterclaim in a lawsuit) alleging that the Program\n\nitself (excluding combinations of the Program with other software or\n\nhardware) infringes such Recipient&apos;s patent(s), then such\nRecipient&apos;s\n\nrights granted under Section 2(b) shall terminate as of the date such\n\nlitigation is filed.\n\nAll Recipient&apos;s rights under this Agreement shall terminate if it\n\nfails to comply with any of the material terms or conditions of this\n\nAgreement and does not cure such failure in a reasonable period of\n\ntime after becoming aware of such noncompliance. If all Recipient&apos;s\n\nrights under this Agreement terminate, Recipient agrees to cease use\n\nand distribution of the Program as soon as reasonably practicable.\n\nHowever, Recipient&apos;s obligations under this Agreement and any\n\nlicenses granted by Recipient relating to the Program shall continue\n\nand survive.\n\nIBM may publish new versions (including revisions) of this Agreement\n\nfrom time to time. Each new version of the Agreement will be given a\n\ndistinguishing version number. The Program (including Contributions)\n\nmay always be distributed subject to the version of the Agreement\n\nunder which it was received. In addition, after a new version of the\n\nAgreement is published, Contributor may elect to distribute the\n\nProgram (including its Contributions) under the new version. No one\n\nother than IBM has the right to modify this Agreement. Except as\n\nexpressly stated in Sections 2(a) and 2(b) above, Recipient receives\n\nno rights or licenses to the intellectual property of any Contributor\n\nunder this Agreement, whether expressly, by implication, estoppel or\n\notherwise. All rights in the Program not expressly granted under\n\nthis Agreement are reserved.\n\nThis Agreement is governed by the laws of the State of New York and\n\nthe intellectual property laws of the United States of America. No\n\nparty to this Agreement will bring a legal action under this\n\nAgreement more than one year after the cause of action arose. Each\n\nparty waives its rights to a jury trial in any resulting litigation.\n\n" +
+	"", etag: `"qur4AgbB0iY="`})
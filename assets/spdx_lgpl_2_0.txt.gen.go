@@ -0,0 +1,7 @@
+// AUTOMATICALLY GENERATED FILE. DO NOT EDIT.
+
+package assets
+
+var spdx_lgpl_2_0 = txt(asset{Name: "spdx_lgpl_2_0.txt", Content: "" +
+	"---\ntitle: GNU Library General Public License v2\nspdx-id: LGPL-2.0\nsource: https://spdx.org/licenses/LGPL-2.0-only.html\nosi-approved: true\nfsf-libre: false\n---\n\nEveryone is permitted to copy and distribute verbatim copies of this license\ndocument, but changing it is not allowed.\n\n[This is the first released version of the library GPL. It is numbered 2 because\nit goes with version 2 of the ordinary GPL.]\n\nPreamble\n\nThe licenses for most software are designed to take away your freedom to share\nand change it. By contrast, the GNU General Public Licenses are intended to\nguarantee your freedom to share and change free software--to make sure the\nsoftware is free for all its users.\n\nThis license, the Library General Public License, applies to some specially\ndesignated Free Software Foundation software, and to any other libraries whose\nauthors decide to use it. You can use it for your libraries, too.\n\nWhen we speak of free software, we are referring to freedom, not price. Our\nGeneral Public Licenses are designed to make sure that you have the freedom\nto distribute copies of free software (and charge for this service if you\nwish), that you receive source code or can get it if you want it, that you\ncan change the software or use pieces of it in new free programs; and that\nyou know you can do these things.\n\nTo protect your rights, we need to make restrictions that forbid anyone to\ndeny you these rights or to ask you to surrender the rights. These restrictions\ntranslate to certain responsibilities for you if you distribute copies of\nthe library, or if you modify it.\n\nFor example, if you distribute copies of the library, whether gratis or for\na fee, you must give the recipients all the rights that we gave you. You must\nmake sure that they, too, receive or can get the source code. If you link\na program with the library, you must provide complete object files to the\nrecipients so that they can relink them with the library, after making changes\nto the library and recompiling it. And you must show them these terms so they\nknow their rights.\n\nOur method of protecting your rights has two steps: (1) copyright the library,\nand (2) offer you this license which gives you legal permission to copy, distribute\nand/or modify the library.\n\nAlso, for each distributor's protection, we want to make certain that everyone\nunderstands that there is no warranty for this free library. If the library\nis modified by someone else and passed on, we want its recipients to know\nthat what they have is not the original version, so that any problems introduced\nby others will not reflect on the original authors' reputations.\n\nFinally, any free program is threatened constantly by software patents. We\nwish to avoid the danger that companies distributing free software will individually\nobtain patent licenses, thus in effect transforming the program into proprietary\nsoftware. To prevent this, we have made it clear that any patent must be licensed\nfor everyone's free use or not licensed at all.\n\nMost GNU software, including some libraries, is covered by the ordinary GNU\nGeneral Public License, which was designed for utility programs. This license,\nthe GNU Library General Public License, applies to certain designated libraries.\nThis license is quite different from the ordinary one; be sure to read it\nin full, and don't assume that anything in it is the same as in the ordinary\nlicense.\n\nThe reason we have a separate public license for some libraries is that they\nblur the distinction we usually make between modifying or adding to a program\nand simply using it. Linking a program with a library, without changing the\nlibrary, is in some sense simply using the library, and is analogous to running\na utility program or application program. However, in a textual and legal\nsense, the linked executable is a combined work, a derivative of the original\nlibrary, and the ordinary General Public License treats it as such.\n\nBecause of this blurred distinction, using the ordinary General Public License\nfor libraries did not effectively promote software sharing, because most developers\ndid not use the libraries. We concluded that weaker conditions might promote\nsharing better.\n\nHowever, unrestricted linking of non-free programs would deprive the users\nof those programs of all benefit from the free status of the libraries themselves.\nThis Library General Public License is intended to permit developers of non-free\nprograms to use free libraries, while preserving your freedom as a user of\nsuch programs to change the free libraries that are incorporated in them.\n(We have not seen how to achieve this as regards changes in header files,\nbut we have achieved it as regards changes in the actual functions of the\nLibrary.) The hope is that this will lead to faster development of free libraries.\n\nThe precise terms and conditions for copying, distribution and modification\nfollow. Pay close attention to the difference between a \"work based on the\nlibrary\" and a \"work that uses the library\". The former contains code derived\nfrom the library, while the latter only works together with the library.\n\nNote that it is possible for a library to be covered by the ordinary General\nPublic License rather than by this special one.\n\nTERMS AND CONDITIONS FOR COPYING, DISTRIBUTION AND MODIFICATION\n\n0. This License Agreement applies to any software library which contains a\nnotice placed by the copyright holder or other authorized party saying it\nmay be distributed under the terms of this Library General Public License\n(also called \"this License\"). Each licensee is addressed as \"you\".\n\nA \"library\" means a collection of software functions and/or data prepared\nso as to be conveniently linked with application programs (which use some\nof those functions and data) to form executables.\n\nThe \"Library\", below, refers to any such software library or work which has\nbeen distributed under these terms. A \"work based on the Library\" means either\nthe Library or any derivative work under copyright law: that is to say, a\nwork containing the Library or a portion of it, either verbatim or with modifications\nand/or translated straightforwardly into another language. (Hereinafter, translation\nis included without limitation in the term \"modification\".)\n\n\"Source code\" for a work means the preferred form of the work for making modifications\nto it. For a library, complete source code means all the source code for all\nmodules it contains, plus any associated interface definition files, plus\nthe scripts used to control compilation and installation of the library.\n\nActivities other than copying, distribution and modification are not covered\nby this License; they are outside its scope. The act of running a program\nusing the Library is not restricted, and output from such a program is covered\nonly if its contents constitute a work based on the Library (independent of\nthe use of the Library in a tool for writing it). Whether that is true depends\non what the Library does and what the program that uses the Library does.\n\n1. You may copy and distribute verbatim copies of the Library's complete source\ncode as you receive it, in any medium, provided that you conspicuously and\nappropriately publish on each copy an appropriate copyright notice and disclaimer\nof warranty; keep intact all the notices that refer to this License and to\nthe absence of any warranty; and distribute a copy of this License along with\nthe Library.\n\nYou may charge a fee for the physical act of transferring a copy, and you\nmay at your option offer warranty protection in exchange for a fee.\n\n2. You may modify your copy or copies of the Library or any portion of it,\nthus forming a work based on the Library, and copy and distribute such modifications\nor work under the terms of Section 1 above, provided that you also meet all\nof these conditions:\n\n      a) The modified work must itself be a software library.\n\nb) You must cause the files modified to carry prominent notices stating that\nyou changed the files and the date of any change.\n\nc) You must cause the whole of the work to be licensed at no charge to all\nthird parties under the terms of this License.\n\nd) If a facility in the modified Library refers to a function or a table of\ndata to be supplied by an application program that uses the facility, other\nthan as an argument passed when the facility is invoked, then you must make\na good faith effort to ensure that, in the event an application does not supply\nsuch function or table, the facility still operates, and performs whatever\npart of its purpose remains meaningful.\n\n(For example, a function in a library to compute square roots has a purpose\nthat is entirely well-defined independent of the application. Therefore, Subsection\n2d requires that any application-supplied function or table used by this function\nmust be optional: if the application does not supply it, the square root function\nmust still compute square roots.)\n\nThese requirements apply to the modified work as a whole. If identifiable\nsections of that work are not derived from the Library, and can be reasonably\nconsidered independent and separate works in themselves, then this License,\nand its terms, do not apply to those sections when you distribute them as\nseparate works. But when you distribute the same sections as part of a whole\nwhich is a work based on the Library, the distribution of the whole must be\non the terms of this License, whose permissions for other licensees extend\nto the entire whole, and thus to each and every part regardless of who wrote\nit.\n\nThus, it is not the intent of this section to claim rights or contest your\nrights to work written entirely by you; rather, the intent is to exercise\nthe right to control the distribution of derivative or collective works based\non the Library.\n\nIn addition, mere aggregation of another work not based on the Library with\nthe Library (or with a work based on the Library) on a volume of a storage\nor distribution medium does not bring the other work under the scope of this\nLicense.\n\n3. You may opt to apply the terms of the ordinary GNU General Public License\ninstead of this License to a given copy of the Library. To do this, you must\nalter all the notices that refer to this License, so that they refer to the\nordinary GNU General Public License, version 2, instead of to this License.\n(If a newer version than version 2 of the ordinary GNU General Public License\nhas appeared, then you can specify that version instead if you wish.) Do not\nmake any other change in these notices.\n\nOnce this change is made in a given copy, it is irreversible for that copy,\nso the ordinary GNU General Public License applies to all subsequent copies\nand derivative works made from that copy.\n\nThis option is useful when you wish to copy part of the code of the Library\ninto a program that is not a library.\n\n4. You may copy and distribute the Library (or a portion or derivative of\nit, under Section 2) in object code or executable form under the terms of\nSections 1 and 2 above provided that you accompany it with the complete corresponding\nmachine-readable source code, which must be distributed under the terms of\nSections 1 and 2 above on a medium customarily used for software interchange.\n\nIf distribution of object code is made by offering access to copy from a designated\nplace, then offering equivalent access to copy the source code from the same\nplace satisfies the requirement to distribute the source code, even though\nthird parties are not compelled to copy the source along with the object code.\n\n5. A program that contains no derivative of any portion of the Library, but\nis designed to work with the Library by being compiled or linked with it,\nis called a \"work that uses the Library\". Such a work, in isolation, is not\na derivative work of the Library, and therefore falls outside the scope of\nthis License.\n\nHowever, linking a \"work that uses the Library\" with the Library creates an\nexecutable that is a derivative of the Library (because it contains portions\nof the Library), rather than a \"work that uses the library\". The executable\nis therefore covered by this License. Section 6 states terms for distribution\nof such executables.\n\nWhen a \"work that uses the Library\" uses material from a header file that\nis part of the Library, the object code for the work may be a derivative work\nof the Library even though the source code is not. Whether this is true is\nespecially significant if the work can be linked without the Library, or if\nthe work is itself a library. The threshold for this to be true is not precisely\ndefined by law.\n\nIf such an object file uses only numerical parameters, data structure layouts\nand accessors, and small macros and small inline functions (ten lines or less\nin length), then the use of the object file is unrestricted, regardless of\nwhether it is legally a derivative work. (Executables containing this object\ncode plus portions of the Library will still fall under Section 6.)\n\nOtherwise, if the work is a derivative of the Library, you may distribute\nthe object code for the work under the terms of Section 6. Any executables\ncontaining that work also fall under Section 6, whether or not they are linked\ndirectly with the Library itself.\n\n6. As an exception to the Sections above, you may also compile or link a \"work\nthat uses the Library\" with the Library to produce a work containing portions\nof the Library, and distribute that work under terms of your choice, provided\nthat the terms permit modification of the work for the customer's own use\nand reverse engineering for debugging such modifications.\n\nYou must give prominent notice with each copy of the work that the Library\nis used in it and that the Library and its use are covered by this License.\nYou must supply a copy of this License. If the work during execution displays\ncopyright notices, you must include the copyright notice for the Library among\nthem, as well as a reference directing the user to the copy of this License.\nAlso, you must do one of these things:\n\na) Accompany the work with the complete corresponding machine-readable source\ncode for the Library including whatever changes were used in the work (which\nmust be distributed under Sections 1 and 2 above); and, if the work is an\nexecutable linked with the Library, with the complete machine-readable \"work\nthat uses the Library\", as object code and/or source code, so that the user\ncan modify the Library and then relink to produce a modified executable containing\nthe modified Library. (It is understood that the user who changes the contents\nof definitions files in the Library will not necessarily be able to recompile\nthe application to use the modified definitions.)\n\nb) Accompany the work with a written offer, valid for at least three years,\nto give the same user the materials specified in Subsection 6a, above, for\na charge no more than the cost of performing this distribution.\n\nc) If distribution of the work is made by offering access to copy from a designated\nplace, offer equivalent access to copy the above specified materials from\nthe same place.\n\nd) Verify that the user has already received a copy of these materials or\nthat you have already sent this user a copy.\n\nFor an executable, the required form of the \"work that uses the Library\" must\ninclude any data and utility programs needed for reproducing the executable\nfrom it. However, as a special exception, the source code distributed need\nnot include anything that is normally distributed (in either source or binary\nform) with the major components (compiler, kernel, and so on) of the operating\nsystem on which the executable runs, unless that component itself accompanies\nthe executable.\n\nIt may happen that this requirement contradicts the license restrictions of\nother proprietary libraries that do not normally accompany the operating system.\nSuch a contradiction means you cannot use both them and the Library together\nin an executable that you distribute.\n\n7. You may place library facilities that are a work based on the Library side-by-side\nin a single library together with other library facilities not covered by\nthis License, and distribute such a combined library, provided that the separate\ndistribution of the work based on the Library and of the other library facilities\nis otherwise permitted, and provided that you do these two things:\n\na) Accompany the combined library with a copy of the same work based on the\nLibrary, uncombined with any other library facilities. This must be distributed\nunder the terms of the Sections above.\n\nb) Give prominent notice with the combined library of the fact that part of\nit is a work based on the Library, and explaining where to find the accompanying\nuncombined form of the same work.\n\n8. You may not copy, modify, sublicense, link with, or distribute the Library\nexcept as expressly provided under this License. Any attempt otherwise to\ncopy, modify, sublicense, link with, or distribute the Library is void, and\nwill automatically terminate your rights under this License. However, parties\nwho have received copies, or rights, from you under this License will not\nhave their licenses terminated so long as such parties remain in full compliance.\n\n9. You are not required to accept this License, since you have not signed\nit. However, nothing else grants you permission to modify or distribute the\nLibrary or its derivative works. These actions are prohibited by law if you\ndo not accept this License. Therefore, by modifying or distributing the Library\n(or any work based on the Library), you indicate your acceptance of this License\nto do so, and all its terms and conditions for copying, distributing or modifying\nthe Library or works based on it.\n\n10. Each time you redistribute the Library (or any work based on the Library),\nthe recipient automatically receives a license from the original licensor\nto copy, distribute, link with or modify the Library subject to these terms\nand conditions. You may not impose any further restrictions on the recipients'\nexercise of the rights granted herein. You are not responsible for enforcing\ncompliance by third parties to this License.\n\n11. If, as a consequence of a court judgment or allegation of patent infringement\nor for any other reason (not limited to patent issues), conditions are imposed\non you (whether by court order, agreement or otherwise) that contradict the\nconditions of this License, they do not excuse you from the conditions of\nthis License. If you cannot distribute so as to satisfy simultaneously your\nobligations under this License and any other pertinent obligations, then as\na consequence you may not distribute the Library at all. For example, if a\npatent license would not permit royalty-free redistribution of the Library\nby all those who receive copies directly or indirectly through you, then the\nonly way you could satisfy both it and this License would be to refrain entirely\nfrom distribution of the Library.\n\nIf any portion of this section is held invalid or unenforceable under any\nparticular circumstance, the balance of the section is intended to apply,\nand the section as a whole is intended to apply in other circumstances.\n\nIt is not the purpose of this section to induce you to infringe any patents\nor other property right claims or to contest validity of any such claims;\nthis section has the sole purpose of protecting the integrity of the free\nsoftware distribution system which is implemented by public license practices.\nMany people have made generous contributions to the wide range of software\ndistributed through that system in reliance on consistent application of that\nsystem; it is up to the author/donor to decide if he or she is willing to\ndistribute software through any other system and a licensee cannot impose\nthat choice.\n\nThis section is intended to make thoroughly clear what is believed to be a\nconsequence of the rest of this License.\n\n12. If the distribution and/or use of the Library is restricted in certain\ncountries either by patents or by copyrighted interfaces, the original copyright\nholder who places the Library under this License may add an explicit geographical\ndistribution limitation excluding those countries, so that distribution is\npermitted only in or among countries not thus excluded. In such case, this\nLicense incorporates the limitation as if written in the body of this License.\n\n13. The Free Software Foundation may publish revised and/or new versions of\nthe Library General Public License from time to time. Such new versions will\nbe similar in spirit to the present version, but may differ in detail to address\nnew problems or concerns.\n\nEach version is given a distinguishing version number. If the Library specifies\na version number of this License which applies to it and \"any later version\",\nyou have the option of following the terms and conditions either of that version\nor of any later version published by the Free Software Foundation. If the\nLibrary does not specify a license version number, you may choose any version\never published by the Free Software Foundation.\n\n14. If you wish to incorporate parts of the Library into other free programs\nwhose distribution conditions are incompatible with these, write to the author\nto ask for permission. For software which is copyrighted by the Free Software\nFoundation, write to the Free Software Foundation; we sometimes make exceptions\nfor this. Our decision will be guided by the two goals of preserving the free\nstatus of all derivatives of our free software and of promoting the sharing\nand reuse of software generally.\n\n   NO WARRANTY\n\n15. BECAUSE THE LIBRARY IS LICENSED FREE OF CHARGE, THERE IS NO WARRANTY FOR\nTHE LIBRARY, TO THE EXTENT PERMITTED BY APPLICABLE LAW. EXCEPT WHEN OTHERWISE\nSTATED IN WRITING THE COPYRIGHT HOLDERS AND/OR OTHER PARTIES PROVIDE THE LIBRARY\n\"AS IS\" WITHOUT WARRANTY OF ANY KIND, EITHER EXPRESSED OR IMPLIED, INCLUDING,\nBUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS\nFOR A PARTICULAR PURPOSE. THE ENTIRE RISK AS TO THE QUALITY AND PERFORMANCE\nOF THE LIBRARY IS WITH YOU. SHOULD THE LIBRARY PROVE DEFECTIVE, YOU ASSUME\nTHE COST OF ALL NECESSARY SERVICING, REPAIR OR CORRECTION.\n\n16. IN NO EVENT UNLESS REQUIRED BY APPLICABLE LAW OR AGREED TO IN WRITING\nWILL ANY COPYRIGHT HOLDER, OR ANY OTHER PARTY WHO MAY MODIFY AND/OR REDISTRIBUTE\nTHE LIBRARY AS PERMITTED ABOVE, BE LIABLE TO YOU FOR DAMAGES, INCLUDING ANY\nGENERAL, SPECIAL, INCIDENTAL OR CONSEQUENTIAL DAMAGES ARISING OUT OF THE USE\nOR INABILITY TO USE THE LIBRARY (INCLUDING BUT NOT LIMITED TO LOSS OF DATA\nOR DATA BEING RENDERED INACCURATE OR LOSSES SUSTAINED BY YOU OR THIRD PARTIES\nOR A FAILURE OF THE LIBRARY TO OPERATE WITH ANY OTHER SOFTWARE), EVEN IF SUCH\nHOLDER OR OTHER PARTY HAS BEEN ADVISED OF THE POSSIBILITY OF SUCH DAMAGES.\nEND OF TERMS AND CONDITIONS\n" +
+	"", etag: `"6sC/qWtwTTQ="`})
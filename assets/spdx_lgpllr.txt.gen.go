@@ -0,0 +1,7 @@
+// AUTOMATICALLY GENERATED FILE. DO NOT EDIT.
+
+package assets
+
+var spdx_lgpllr = txt(asset{Name: "spdx_lgpllr.txt", Content: "" +
+	"---\ntitle: Lesser General Public License For Linguistic Resources\nspdx-id: LGPLLR\nsource: https://spdx.org/licenses/LGPLLR.html\nosi-approved: false\nfsf-libre: false\n---\n\nLesser General Public License For Linguistic Resources\n\nPreamble\n\nThe licenses for most data are designed to take away your freedom to share and\nchange it. By contrast, this License is intended to guarantee your freedom to\nshare and change free data--to make sure the data are free for all their\nusers.\n\nThis License, the Lesser General Public License for Linguistic Resources,\napplies to some specially designated linguistic resources -- typically\nlexicons and grammars.\n\nTERMS AND CONDITIONS FOR COPYING, DISTRIBUTION AND MODIFICATION\n\n0. This License Agreement applies to any Linguistic Resource which contains a notice placed by the copyright holder or other authorized party saying it may be distributed under the terms of this Lesser General Public License for Linguistic Resources (also called \"this License\"). Each licensee is addressed as \"you\". \n\nA \"linguistic resource\" means a collection of data about language prepared so\nas to be used with application programs.\n\nThe \"Linguistic Resource\", below, refers to any such work which has been\ndistributed under these terms. A \"work based on the Linguistic Resource\" means\neither the Linguistic Resource or any derivative work under copyright law:\nthat is to say, a work containing the Linguistic Resource or a portion of it,\neither verbatim or with modifications and/or translated straightforwardly into\nanother language. (Hereinafter, translation is included without limitation in\nthe term \"modification\".)\n\n\"Legible form\" for a linguistic resource means the preferred form of the\nresource for making modifications to it.\n\nActivities other than copying, distribution and modification are not covered\nby this License; they are outside its scope. The act of running a program\nusing the Linguistic Resource is not restricted, and output from such a\nprogram is covered only if its contents constitute a work based on the\nLinguistic Resource (independent of the use of the Linguistic Resource in a\ntool for writing it). Whether that is true depends on what the program that\nuses the Linguistic Resource does.\n\n1. You may copy and distribute verbatim copies of the Linguistic Resource as you receive it, in any medium, provided that you conspicuously and appropriately publish on each copy an appropriate copyright notice and disclaimer of warranty; keep intact all the notices that refer to this License and to the absence of any warranty; and distribute a copy of this License along with the Linguistic Resource. \n\nYou may charge a fee for the physical act of transferring a copy, and you may\nat your option offer warranty protection in exchange for a fee.\n\n2. You may modify your copy or copies of the Linguistic Resource or any portion of it, thus forming a work based on the Linguistic Resource, and copy and distribute such modifications or work under the terms of Section 1 above, provided that you also meet all of these conditions: \n\na) The modified work must itself be a linguistic resource.\n\nb) You must cause the files modified to carry prominent notices stating that\nyou changed the files and the date of any change.\n\nc) You must cause the whole of the work to be licensed at no charge to all\nthird parties under the terms of this License.\n\nThese requirements apply to the modified work as a whole. If identifiable\nsections of that work are not derived from the Linguistic Resource, and can be\nreasonably considered independent and separate works in themselves, then this\nLicense, and its terms, do not apply to those sections when you distribute\nthem as separate works. But when you distribute the same sections as part of a\nwhole which is a work based on the Linguistic Resource, the distribution of\nthe whole must be on the terms of this License, whose permissions for other\nlicensees extend to the entire whole, and thus to each and every part\nregardless of who wrote it.\n\nThus, it is not the intent of this section to claim rights or contest your\nrights to work written entirely by you; rather, the intent is to exercise the\nright to control the distribution of derivative or collective works based on\nthe Linguistic Resource.\n\nIn addition, mere aggregation of another work not based on the Linguistic\nResource with the Linguistic Resource (or with a work based on the Linguistic\nResource) on a volume of a storage or distribution medium does not bring the\nother work under the scope of this License.\n\n3. A program that contains no derivative of any portion of the Linguistic Resource, but is designed to work with the Linguistic Resource (or an encrypted form of the Linguistic Resource) by reading it or being compiled or linked with it, is called a \"work that uses the Linguistic Resource\". Such a work, in isolation, is not a derivative work of the Linguistic Resource, and therefore falls outside the scope of this License. \n\nHowever, combining a \"work that uses the Linguistic Resource\" with the\nLinguistic Resource (or an encrypted form of the Linguistic Resource) creates\na package that is a derivative of the Linguistic Resource (because it contains\nportions of the Linguistic Resource), rather than a \"work that uses the\nLinguistic Resource\". If the package is a derivative of the Linguistic\nResource, you may distribute the package under the terms of Section 4. Any\nworks containing that package also fall under Section 4.\n\n4. As an exception to the Sections above, you may also combine a \"work that uses the Linguistic Resource\" with the Linguistic Resource (or an encrypted form of the Linguistic Resource) to produce a package containing portions of the Linguistic Resource, and distribute that package under terms of your choice, provided that the terms permit modification of the package for the customer&apos;s own use and reverse engineering for debugging such modifications. \n\nYou must give prominent notice with each copy of the package that the\nLinguistic Resource is used in it and that the Linguistic Resource and its use\nare covered by this License. You must supply a copy of this License. If the\npackage during execution displays copyright notices, you must include the\ncopyright notice for the Linguistic Resource among them, as well as a\nreference directing the user to the copy of this License. Also, you must do\none of these things:\n\na) Accompany the package with the complete corresponding machine-readable\nlegible form of the Linguistic Resource including whatever changes were used\nin the package (which must be distributed under Sections 1 and 2 above); and,\nif the package contains an encrypted form of the Linguistic Resource, with the\ncomplete machine-readable \"work that uses the Linguistic Resource\", as object\ncode and/or source code, so that the user can modify the Linguistic Resource\nand then encrypt it to produce a modified package containing the modified\nLinguistic Resource.\n\nb) Use a suitable mechanism for combining with the Linguistic Resource. A\nsuitable mechanism is one that will operate properly with a modified version\nof the Linguistic Resource, if the user installs one, as long as the modified\nversion is interface-compatible with the version that the package was made\nwith.\n\nc) Accompany the package with a written offer, valid for at least three years,\nto give the same user the materials specified in Subsection 4a, above, for a\ncharge no more than the cost of performing this distribution.\n\nd) If distribution of the package is made by offering access to copy from a\ndesignated place, offer equivalent access to copy the above specified\nmaterials from the same place.\n\ne) Verify that the user has already received a copy of these materials or that\nyou have already sent this user a copy.\n\nIf the package includes an encrypted form of the Linguistic Resource, the\nrequired form of the \"work that uses the Linguistic Resource\" must include any\ndata and utility programs needed for reproducing the package from it. However,\nas a special exception, the materials to be distributed need not include\nanything that is normally distributed (in either source or binary form) with\nthe major components (compiler, kernel, and so on) of the operating system on\nwhich the executable runs, unless that component itself accompanies the\nexecutable.\n\nIt may happen that this requirement contradicts the license restrictions of\nproprietary libraries that do not normally accompany the operating system.\nSuch a contradiction means you cannot use both them and the Linguistic\nResource together in a package that you distribute.\n\n5. You may not copy, modify, sublicense, link with, or distribute the Linguistic Resource except as expressly provided under this License. Any attempt otherwise to copy, modify, sublicense, link with, or distribute the Linguistic Resource is void, and will automatically terminate your rights under this License. However, parties who have received copies, or rights, from you under this License will not have their licenses terminated so long as such parties remain in full compliance. \n\n6. You are not required to accept this License, since you have not signed it. However, nothing else grants you permission to modify or distribute the Linguistic Resource or its derivative works. These actions are prohibited by law if you do not accept this License. Therefore, by modifying or distributing the Linguistic Resource (or any work based on the Linguistic Resource), you indicate your acceptance of this License to do so, and all its terms and conditions for copying, distributing or modifying the Linguistic Resource or works based on it. \n\n7. Each time you redistribute the Linguistic Resource (or any work based on the Linguistic Resource), the recipient automatically receives a license from the original licensor to copy, distribute, link with or modify the Linguistic Resource subject to these terms and conditions. You may not impose any further restrictions on the recipients&apos; exercise of the rights granted herein. You are not responsible for enforcing compliance by third parties with this License. \n\n8. If, as a consequence of a court judgment or allegation of patent infringement or for any other reason (not limited to patent issues), conditions are imposed on you (whether by court order, agreement or otherwise) that contradict the conditions of this License, they do not excuse you from the conditions of this License. If you cannot distribute so as to satisfy simultaneously your obligations under this License and any other pertinent obligations, then as a consequence you may not distribute the Linguistic Resource at all. For example, if a patent license would not permit royalty-free redistribution of the Linguistic Resource by all those who receive copies directly or indirectly through you, then the only way you could satisfy both it and this License would be to refrain entirely from distribution of the Linguistic Resource. \n\nIf any portion of this section is held invalid or unenforceable under any\nparticular circumstance, the balance of the section is intended to apply, and\nthe section as a whole is intended to apply in other circumstances.\n\nIt is not the purpose of this section to induce you to infringe any patents or\nother property right claims or to contest validity of any such claims; this\nsection has the sole purpose of protecting the integrity of the free resource\ndistribution system which is implemented by public license practices. Many\npeople have made generous contributions to the wide range of data distributed\nthrough that system in reliance on consistent application of that system; it\nis up to the author/donor to decide if he or she is willing to distribute\nresources through any other system and a licensee cannot impose that choice.\n\nThis section is intended to make thoroughly clear what is believed to be a\nconsequence of the rest of this License.\n\n9. If the distribution and/or use of the Linguistic Resource is restricted in certain countries either by patents or by copyrighted interfaces, the original copyright holder who places the Linguistic Resource under this License may add an explicit geographical distribution limitation excluding those countries, so that distribution is permitted only in or among countries not thus excluded. In such case, this License incorporates the limitation as if written in the body of this License. \n\n10. The Free Software Foundation may publish revised and/or new versions of the Lesser General Public License for Linguistic Resources from time to time. Such new versions will be similar in spirit to the present version, but may differ in detail to address new problems or concerns. \n\nEach version is given a distinguishing version number. If the Linguistic\nResource specifies a version number of this License which applies to it and\n\"any later version\", you have the option of following the terms and conditions\neither of that version or of any later version published by the Free Software\nFoundation. If the Linguistic Resource does not specify a license version\nnumber, you may choose any version ever published by the Free Software\nFoundation.\n\n11. If you wish to incorporate parts of the Linguistic Resource into other free programs whose distribution conditions are incompatible with these, write to the author to ask for permission. \n\nNO WARRANTY\n\n12. BECAUSE THE LINGUISTIC RESOURCE IS LICENSED FREE OF CHARGE, THERE IS NO WARRANTY FOR THE LINGUISTIC RESOURCE, TO THE EXTENT PERMITTED BY APPLICABLE LAW. EXCEPT WHEN OTHERWISE STATED IN WRITING THE COPYRIGHT HOLDERS AND/OR OTHER PARTIES PROVIDE THE LINGUISTIC RESOURCE \"AS IS\" WITHOUT WARRANTY OF ANY KIND, EITHER EXPRESSED OR IMPLIED, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE. THE ENTIRE RISK AS TO THE QUALITY AND PERFORMANCE OF THE LINGUISTIC RESOURCE IS WITH YOU. SHOULD THE LINGUISTIC RESOURCE PROVE DEFECTIVE, YOU ASSUME THE COST OF ALL NECESSARY SERVICING, REPAIR OR CORRECTION. \n\n13. IN NO EVENT UNLESS REQUIRED BY APPLICABLE LAW OR AGREED TO IN WRITING WILL ANY COPYRIGHT HOLDER, OR ANY OTHER PARTY WHO MAY MODIFY AND/OR REDISTRIBUTE THE LINGUISTIC RESOURCE AS PERMITTED ABOVE, BE LIABLE TO YOU FOR DAMAGES, INCLUDING ANY GENERAL, SPECIAL, INCIDENTAL OR CONSEQUENTIAL DAMAGES ARISING OUT OF THE USE OR INABILITY TO USE THE LINGUISTIC RESOURCE (INCLUDING BUT NOT LIMITED TO LOSS OF DATA OR DATA BEING RENDERED INACCURATE OR LOSSES SUSTAINED BY YOU OR THIRD PARTIES OR A FAILURE OF THE LINGUISTIC RESOURCE TO OPERATE WITH ANY OTHER SOFTWARE), EVEN IF SUCH HOLDER OR OTHER PARTY HAS BEEN ADVISED OF THE POSSIBILITY OF SUCH DAMAGES. \n\nEND OF TERMS AND CONDITIONS\n\n" +
+	"", etag: `"qTZ6HDs81j0="`})
@@ -0,0 +1,7 @@
+// AUTOMATICALLY GENERATED FILE. DO NOT EDIT.
+
+package assets
+
+var spdx_libpng = txt(asset{Name: "spdx_libpng.txt", Content: "" +
+	"---\ntitle: libpng License\nspdx-id: Libpng\nsource: https://spdx.org/licenses/Libpng.html\nosi-approved: false\nfsf-libre: false\n---\n\nThis copy of the libpng notices is provided for your convenience. In case of\nany discrepancy between this copy and the notices in the file png.h that is\nincluded in the libpng distribution, the latter shall prevail.\n\nCOPYRIGHT NOTICE, DISCLAIMER, and LICENSE:\n\nIf you modify libpng you may insert additional notices immediately following\nthis sentence.\n\nThis code is released under the libpng license.\n\nlibpng versions 1.2.6, August 15, 2004, through 1.4.5, December 9, 2010, are\nCopyright (c) 2004, 2006-2010 Glenn Randers-Pehrson, and are distributed\naccording to the same disclaimer and license as libpng-1.2.5 with the\nfollowing individual added to the list of Contributing Authors\n\nCosmin Truta\n\nlibpng versions 1.0.7, July 1, 2000, through 1.2.5 - October 3, 2002, are\n\nCopyright (c) 2000-2002 Glenn Randers-Pehrson, and are distributed according\nto the same disclaimer and license as libpng-1.0.6 with the following\nindividuals added to the list of Contributing Authors\n\nSimon-Pierre Cadieux\n\nEric S. Raymond\n\nGilles Vollant\n\nand with the following additions to the disclaimer:\n\nThere is no warranty against interference with your enjoyment of the library\nor against infringement. There is no warranty that our efforts or the library\nwill fulfill any of your particular purposes or needs. This library is\nprovided with all faults, and the entire risk of satisfactory quality,\nperformance, accuracy, and effort is with the user.\n\nlibpng versions 0.97, January 1998, through 1.0.6, March 20, 2000, are\n\nCopyright (c) 1998, 1999 Glenn Randers-Pehrson, and are distributed according\nto the same disclaimer and license as libpng-0.96, with the following\nindividuals added to the list of Contributing Authors:\n\nTom Lane\n\nGlenn Randers-Pehrson\n\nWillem van Schaik\n\nlibpng versions 0.89, June 1996, through 0.96, May 1997, are\n\nCopyright (c) 1996, 1997 Andreas Digger\n\nDistributed according to the same disclaimer and license as libpng-0.88, with\nthe following individuals added to the list of Contributing Authors:\n\nJohn Bowler\n\nKevin Bracey\n\nSam Bushell\n\nMagnus Holmgren\n\nGreg Roelofs\n\nTom Tanner\n\nlibpng versions 0.5, May 1995, through 0.88, January 1996, are\n\nCopyright (c) 1995, 1996 Guy Eric Schalnat, Group 42, Inc.\n\nFor the purposes of this copyright and license, \"Contributing Authors\" is\ndefined as the following set of individuals:\n\nAndreas Dilger\n\nDave Martindale\n\nGuy Eric Schalnat\n\nPaul Schmidt\n\nTim Wegner\n\nThe PNG Reference Library is supplied \"AS IS\". The Contributing Authors and\nGroup 42, Inc. disclaim all warranties, expressed or implied, including,\nwithout limitation, the warranties of merchantability and of fitness for any\npurpose. The Contributing Authors and Group 42, Inc. assume no liability for\ndirect, indirect, incidental, special, exemplary, or consequential damages,\nwhich may result from the use of the PNG Reference Library, even if advised of\nthe possibility of such damage.\n\nPermission is hereby granted to use, copy, modify, and distribute this source\ncode, or portions hereof, for any purpose, without fee, subject to the\nfollowing restrictions:\n\n1. The origin of this source code must not be misrepresented. \n\n2. Altered versions must be plainly marked as such and must not be misrepresented as being the original source. \n\n3. This Copyright notice may not be removed or altered from any source or altered source distribution. \n\nThe Contributing Authors and Group 42, Inc. specifically permit, without fee,\nand encourage the use of this source code as a component to supporting the PNG\nfile format in commercial products. If you use this source code in a product,\nacknowledgment is not required but would be appreciated.\n\n\nA \"png_get_copyright\" function is available, for convenient use in \"about\"\nboxes and the like:\n\nprintf(\"%s\",png_get_copyright(NULL));\n\nAlso, the PNG logo (in PNG format, of course) is supplied in the files\n\"pngbar.png\" and \"pngbar.jpg (88x31) and \"pngnow.png\" (98x31).\n\nLibpng is OSI Certified Open Source Software. OSI Certified Open Source is a\ncertification mark of the Open Source Initiative.\n\nGlenn Randers-Pehrson\n\nglennrp at users.sourceforge.net\n\nDecember 9, 2010\n\n" +
+	"", etag: `"+aHeX8yKnTQ="`})
@@ -0,0 +1,7 @@
+// AUTOMATICALLY GENERATED FILE. DO NOT EDIT.
+
+package assets
+
+var spdx_lpl_1_0 = txt(asset{Name: "spdx_lpl_1_0.txt", Content: "" +
+	"---\ntitle: Lucent Public License Version 1.0\nspdx-id: LPL-1.0\nsource: https://spdx.org/licenses/LPL-1.0.html\nosi-approved: true\nfsf-libre: false\n---\n\nLucent Public License Version 1.0\n\nTHE ACCOMPANYING PROGRAM IS PROVIDED UNDER THE TERMS OF THIS PUBLIC LICENSE\n(\"AGREEMENT\"). ANY USE, REPRODUCTION OR DISTRIBUTION OF THE PROGRAM\nCONSTITUTES RECIPIENT&apos;S ACCEPTANCE OF THIS AGREEMENT.\n\n1. DEFINITIONS \n\n\"Contribution\" means:\n\na. in the case of <ORGANIZATION> (\" <OWNER> \"), the Original Program, and\n\nb. in the case of each Contributor,\n\ni. changes to the Program, and\n\nii. additions to the Program; where such changes and/or additions to the\nProgram originate from and are \"Contributed\" by that particular Contributor.\n\nA Contribution is \"Contributed\" by a Contributor only (i) if it was added to\nthe Program by such Contributor itself or anyone acting on such\nContributor&apos;s behalf, and (ii) the Contributor explicitly consents, in\naccordance with Section 3C, to characterization of the changes and/or\nadditions as Contributions. Contributions do not include additions to the\nProgram which: (i) are separate modules of software distributed in conjunction\nwith the Program under their own license agreement, and (ii) are not\nderivative works of the Program.\n\n\"Contributor\" means <OWNER> and any other entity that has Contributed a\nContribution to the Program.\n\n\"Distributor\" means a Recipient that distributes the Program, modifications to\nthe Program, or any part thereof.\n\n\"Licensed Patents\" mean patent claims licensable by a Contributor which are\nnecessarily infringed by the use or sale of its Contribution alone or when\ncombined with the Program.\n\n\"Original Program\" means the original version of the software accompanying\nthis Agreement as released by <OWNER> , including source code, object code and\ndocumentation, if any.\n\n\"Program\" means the Original Program and Contributions or any part thereof\n\n\"Recipient\" means anyone who receives the Program under this Agreement,\nincluding all Contributors.\n\n2. GRANT OF RIGHTS \n\na. Subject to the terms of this Agreement, each Contributor hereby grants\nRecipient a non-exclusive, worldwide, royalty-free copyright license to\nreproduce, prepare derivative works of, publicly display, publicly perform,\ndistribute and sublicense the Contribution of such Contributor, if any, and\nsuch derivative works, in source code and object code form.\n\nb. Subject to the terms of this Agreement, each Contributor hereby grants\nRecipient a non-exclusive, worldwide, royalty-free patent license under\nLicensed Patents to make, use, sell, offer to sell, import and otherwise\ntransfer the Contribution of such Contributor, if any, in source code and\nobject code form. The patent license granted by a Contributor shall also apply\nto the combination of the Contribution of that Contributor and the Program if,\nat the time the Contribution is added by the Contributor, such addition of the\nContribution causes such combination to be covered by the Licensed Patents.\nThe patent license granted by a Contributor shall not apply to (i) any other\ncombinations which include the Contribution, nor to (ii) Contributions of\nother Contributors. No hardware per se is licensed hereunder.\n\nc. Recipient understands that although each Contributor grants the licenses to\nits Contributions set forth herein, no assurances are provided by any\nContributor that the Program does not infringe the patent or other\nintellectual property rights of any other entity. Each Contributor disclaims\nany liability to Recipient for claims brought by any other entity based on\ninfringement of intellectual property rights or otherwise. As a condition to\nexercising the rights and licenses granted hereunder, each Recipient hereby\nassumes sole responsibility to secure any other intellectual property rights\nneeded, if any. For example, if a third party patent license is required to\nallow Recipient to distribute the Program, it is Recipient&apos;s\nresponsibility to acquire that license before distributing the Program.\n\nd. Each Contributor represents that to its knowledge it has sufficient\ncopyright rights in its Contribution, if any, to grant the copyright license\nset forth in this Agreement.\n\n3. REQUIREMENTS \n\nA. Distributor may choose to distribute the Program in any form under this\nAgreement or under its own license agreement, provided that:\n\n1. it complies with the terms and conditions of this Agreement;   \n2. if the Program is distributed in source code or other tangible form, a copy of this Agreement or Distributor&apos;s own license agreement is included with each copy of the Program; and   \n3. if distributed under Distributor&apos;s own license agreement, such license agreement: \n\na. effectively disclaims on behalf of all Contributors all warranties and\nconditions, express and implied, including warranties or conditions of title\nand non-infringement, and implied warranties or conditions of merchantability\nand fitness for a particular purpose;\n\nb. effectively excludes on behalf of all Contributors all liability for\ndamages, including direct, indirect, special, incidental and consequential\ndamages, such as lost profits; and\n\nc. states that any provisions which differ from this Agreement are offered by\nthat Contributor alone and not by any other party.\n\nB. Each Distributor must include the following in a conspicuous location in\nthe Program:\n\nCopyright (C) <YEAR>, <ORGANIZATION> and others. All Rights Reserved.\n\nC. In addition, each Contributor must identify itself as the originator of its\nContribution, if any, and indicate its consent to characterization of its\nadditions and/or changes as a Contribution, in a manner that reasonably allows\nsubsequent Recipients to identify the originator of the Contribution. Once\nconsent is granted, it may not thereafter be revoked.\n\n4. COMMERCIAL DISTRIBUTION \n\nCommercial distributors of software may accept certain responsibilities with\nrespect to end users, business partners and the like. While this license is\nintended to facilitate the commercial use of the Program, the Distributor who\nincludes the Program in a commercial product offering should do so in a manner\nwhich does not create potential liability for Contributors. Therefore, if a\nDistributor includes the Program in a commercial product offering, such\nDistributor (\"Commercial Distributor\") hereby agrees to defend and indemnify\nevery Contributor (\"Indemnified Contributor\") against any losses, damages and\ncosts (collectively \"Losses\") arising from claims, lawsuits and other legal\nactions brought by a third party against the Indemnified Contributor to the\nextent caused by the acts or omissions of such Commercial Distributor in\nconnection with its distribution of the Program in a commercial product\noffering. The obligations in this section do not apply to any claims or Losses\nrelating to any actual or alleged intellectual property infringement. In order\nto qualify, an Indemnified Contributor must: a) promptly notify the Commercial\nDistributor in writing of such claim, and b) allow the Commercial Distributor\nto control, and cooperate with the Commercial Distributor in, the defense and\nany related settlement negotiations. The Indemnified Contributor may\nparticipate in any such claim at its own expense.\n\nFor example, a Distributor might include the Program in a commercial product\noffering, Product X. That Distributor is then a Commercial Distributor. If\nthat Commercial Distributor then makes performance claims, or offers\nwarranties related to Product X, those performance claims and warranties are\nsuch Commercial Distributor&apos;s responsibility alone. Under this section,\nthe Commercial Distributor would have to defend claims against the\nContributors related to those performance claims and warranties, and if a\ncourt requires any Contributor to pay any damages as a result, the Commercial\nDistributor must pay those damages.\n\n5. NO WARRANTY \n\nEXCEPT AS EXPRESSLY SET FORTH IN THIS AGREEMENT, THE PROGRAM IS PROVIDED ON AN\n\"AS IS\" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, EITHER EXPRESS OR\nIMPLIED INCLUDING, WITHOUT LIMITATION, ANY WARRANTIES OR CONDITIONS OF TITLE,\nNON-INFRINGEMENT, MERCHANTABILITY OR FITNESS FOR A PARTICULAR PURPOSE. Each\nRecipient is solely responsible for determining the appropriateness of using\nand distributing the Program and assumes all risks associated with its\nexercise of rights under this Agreement, including but not limited to the\nrisks and costs of program errors, compliance with applicable laws, damage to\nor loss of data, programs or equipment, and unavailability or interruption of\noperations.\n\n6. DISCLAIMER OF LIABILITY \n\nEXCEPT AS EXPRESSLY SET FORTH IN THIS AGREEMENT, NEITHER RECIPIENT NOR ANY\nCONTRIBUTORS SHALL HAVE ANY LIABILITY FOR ANY DIRECT, INDIRECT, INCIDENTAL,\nSPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING WITHOUT LIMITATION\nLOST PROFITS), HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN\nCONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)\nARISING IN ANY WAY OUT OF THE USE OR DISTRIBUTION OF THE PROGRAM OR THE\nEXERCISE OF ANY RIGHTS GRANTED HEREUNDER, EVEN IF ADVISED OF THE POSSIBILITY\nOF SUCH DAMAGES.\n\n7. GENERAL \n\nIf any provision of this Agreement is invalid or unenforceable under\napplicable law, it shall not affect the validity or enforceability of the\nremainder of the terms of this Agreement, and without further action by the\nparties hereto, such provision shall be reformed to the minimum extent\nnecessary to make such provision valid and enforceable.\n\nIf Recipient institutes patent litigation against a Contributor with respect\nto a patent applicable to software (including a cross-claim or counterclaim in\na lawsuit), then any patent licenses granted by that Contributor to such\nRecipient under this Agreement shall terminate as of the date such litigation\nis filed. In addition, if Recipient institutes patent litigation against any\nentity (including a cross-claim or counterclaim in a lawsuit) alleging that\nthe Program itself (excluding combinations of the Program with other software\nor hardware) infringes such Recipient&apos;s patent(s), then such\nRecipient&apos;s rights granted under Section 2(b) shall terminate as of the\ndate such litigation is filed.\n\nAll Recipient&apos;s rights under this Agreement shall terminate if it fails\nto comply with any of the material terms or conditions of this Agreement and\ndoes not cure such failure in a reasonable period of time after becoming aware\nof such noncompliance. If all Recipient&apos;s rights under this Agreement\nterminate, Recipient agrees to cease use and distribution of the Program as\nsoon as reasonably practicable. However, Recipient&apos;s obligations under\nthis Agreement and any licenses granted by Recipient relating to the Program\nshall continue and survive.\n\n<OWNER> may publish new versions (including revisions) of this Agreement from\ntime to time. Each new version of the Agreement will be given a distinguishing\nversion number. The Program (including Contributions) may always be\ndistributed subject to the version of the Agreement under which it was\nreceived. In addition, after a new version of the Agreement is published,\nContributor may elect to distribute the Program (including its Contributions)\nunder the new version. No one other than <OWNER> has the right to modify this\nAgreement. Except as expressly stated in Sections 2(a) and 2(b) above,\nRecipient receives no rights or licenses to the intellectual property of any\nContributor under this Agreement, whether expressly, by implication, estoppel\nor otherwise. All rights in the Program not expressly granted under this\nAgreement are reserved.\n\nThis Agreement is governed by the laws of the State of <STATE> and the\nintellectual property laws of the United States of America. No party to this\nAgreement will bring a legal action under this Agreement more than one year\nafter the cause of action arose. Each party waives its rights to a jury trial\nin any resulting litigation.\n\n" +
+	"", etag: `"wtrrXw50spk="`})
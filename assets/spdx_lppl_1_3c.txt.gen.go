@@ -0,0 +1,7 @@
+// AUTOMATICALLY GENERATED FILE. DO NOT EDIT.
+
+package assets
+
+var spdx_lppl_1_3c = txt(asset{Name: "spdx_lppl_1_3c.txt", Content: "" +
+	"---\ntitle: LaTeX Project Public License v1.3c\nspdx-id: LPPL-1.3c\nsource: https://spdx.org/licenses/LPPL-1.3c.html\nosi-approved: true\nfsf-libre: false\n---\n\nThe LaTeX Project Public License\n=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-\n\nLPPL Version 1.3c  2008-05-04\n\nCopyright 1999 2002-2008 LaTeX3 Project\n    Everyone is allowed to distribute verbatim copies of this\n    license document, but modification of it is not allowed.\n\n\nPREAMBLE\n========\n\nThe LaTeX Project Public License (LPPL) is the primary license under\nwhich the LaTeX kernel and the base LaTeX packages are distributed.\n\nYou may use this license for any work of which you hold the copyright\nand which you wish to distribute.  This license may be particularly\nsuitable if your work is TeX-related (such as a LaTeX package), but \nit is written in such a way that you can use it even if your work is \nunrelated to TeX.\n\nThe section `WHETHER AND HOW TO DISTRIBUTE WORKS UNDER THIS LICENSE',\nbelow, gives instructions, examples, and recommendations for authors\nwho are considering distributing their works under this license.\n\nThis license gives conditions under which a work may be distributed\nand modified, as well as conditions under which modified versions of\nthat work may be distributed.\n\nWe, the LaTeX3 Project, believe that the conditions below give you\nthe freedom to make and distribute modified versions of your work\nthat conform with whatever technical specifications you wish while\nmaintaining the availability, integrity, and reliability of\nthat work.  If you do not see how to achieve your goal while\nmeeting these conditions, then read the document `cfgguide.tex'\nand `modguide.tex' in the base LaTeX distribution for suggestions.\n\n\nDEFINITIONS\n===========\n\nIn this license document the following terms are used:\n\n   `Work'\n    Any work being distributed under this License.\n    \n   `Derived Work'\n    Any work that under any applicable law is derived from the Work.\n\n   `Modification' \n    Any procedure that produces a Derived Work under any applicable\n    law -- for example, the production of a file containing an\n    original file associated with the Work or a significant portion of\n    such a file, either verbatim or with modifications and/or\n    translated into another language.\n\n   `Modify'\n    To apply any procedure that produces a Derived Work under any\n    applicable law.\n    \n   `Distribution'\n    Making copies of the Work available from one person to another, in\n    whole or in part.  Distribution includes (but is not limited to)\n    making any electronic components of the Work accessible by\n    file transfer protocols such as FTP or HTTP or by shared file\n    systems such as Sun's Network File System (NFS).\n\n   `Compiled Work'\n    A version of the Work that has been processed into a form where it\n    is directly usable on a computer system.  This processing may\n    include using installation facilities provided by the Work,\n    transformations of the Work, copying of components of the Work, or\n    other activities.  Note that modification of any installation\n    facilities provided by the Work constitutes modification of the Work.\n\n   `Current Maintainer'\n    A person or persons nominated as such within the Work.  If there is\n    no such explicit nomination then it is the `Copyright Holder' under\n    any applicable law.\n\n   `Base Interpreter' \n    A program or process that is normally needed for running or\n    interpreting a part or the whole of the Work.    \n\n    A Base Interpreter may depend on external components but these\n    are not considered part of the Base Interpreter provided that each\n    external component clearly identifies itself whenever it is used\n    interactively.  Unless explicitly specified when applying the\n    license to the Work, the only applicable Base Interpreter is a\n    `LaTeX-Format' or in the case of files belonging to the \n    `LaTeX-format' a program implementing the `TeX language'.\n\n\n\nCONDITIONS ON DISTRIBUTION AND MODIFICATION\n===========================================\n\n1.  Activities other than distribution and/or modification of the Work\nare not covered by this license; they are outside its scope.  In\nparticular, the act of running the Work is not restricted and no\nrequirements are made concerning any offers of support for the Work.\n\n2.  You may distribute a complete, unmodified copy of the Work as you\nreceived it.  Distribution of only part of the Work is considered\nmodification of the Work, and no right to distribute such a Derived\nWork may be assumed under the terms of this clause.\n\n3.  You may distribute a Compiled Work that has been generated from a\ncomplete, unmodified copy of the Work as distributed under Clause 2\nabove, as long as that Compiled Work is distributed in such a way that\nthe recipients may install the Compiled Work on their system exactly\nas it would have been installed if they generated a Compiled Work\ndirectly from the Work.\n\n4.  If you are the Current Maintainer of the Work, you may, without\nrestriction, modify the Work, thus creating a Derived Work.  You may\nalso distribute the Derived Work without restriction, including\nCompiled Works generated from the Derived Work.  Derived Works\ndistributed in this manner by the Current Maintainer are considered to\nbe updated versions of the Work.\n\n5.  If you are not the Current Maintainer of the Work, you may modify\nyour copy of the Work, thus creating a Derived Work based on the Work,\nand compile this Derived Work, thus creating a Compiled Work based on\nthe Derived Work.\n\n6.  If you are not the Current Maintainer of the Work, you may\ndistribute a Derived Work provided the following conditions are met\nfor every component of the Work unless that component clearly states\nin the copyright notice that it is exempt from that condition.  Only\nthe Current Maintainer is allowed to add such statements of exemption \nto a component of the Work. \n\n  a. If a component of this Derived Work can be a direct replacement\n     for a component of the Work when that component is used with the\n     Base Interpreter, then, wherever this component of the Work\n     identifies itself to the user when used interactively with that\n     Base Interpreter, the replacement component of this Derived Work\n     clearly and unambiguously identifies itself as a modified version\n     of this component to the user when used interactively with that\n     Base Interpreter.\n     \n  b. Every component of the Derived Work contains prominent notices\n     detailing the nature of the changes to that component, or a\n     prominent reference to another file that is distributed as part\n     of the Derived Work and that contains a complete and accurate log\n     of the changes.\n  \n  c. No information in the Derived Work implies that any persons,\n     including (but not limited to) the authors of the original version\n     of the Work, provide any support, including (but not limited to)\n     the reporting and handling of errors, to recipients of the\n     Derived Work unless those persons have stated explicitly that\n     they do provide such support for the Derived Work.\n\n  d. You distribute at least one of the following with the Derived Work:\n\n       1. A complete, unmodified copy of the Work; \n          if your distribution of a modified component is made by\n          offering access to copy the modified component from a\n          designated place, then offering equivalent access to copy\n          the Work from the same or some similar place meets this\n          condition, even though third parties are not compelled to\n          copy the Work along with the modified component;\n\n       2. Information that is sufficient to obtain a complete,\n          unmodified copy of the Work.\n\n7.  If you are not the Current Maintainer of the Work, you may\ndistribute a Compiled Work generated from a Derived Work, as long as\nthe Derived Work is distributed to all recipients of the Compiled\nWork, and as long as the conditions of Clause 6, above, are met with\nregard to the Derived Work.\n\n8.  The conditions above are not intended to prohibit, and hence do not\napply to, the modification, by any method, of any component so that it\nbecomes identical to an updated version of that component of the Work as\nit is distributed by the Current Maintainer under Clause 4, above.\n\n9.  Distribution of the Work or any Derived Work in an alternative\nformat, where the Work or that Derived Work (in whole or in part) is\nthen produced by applying some process to that format, does not relax or\nnullify any sections of this license as they pertain to the results of\napplying that process.\n     \n10. a. A Derived Work may be distributed under a different license\n       provided that license itself honors the conditions listed in\n       Clause 6 above, in regard to the Work, though it does not have\n       to honor the rest of the conditions in this license.\n      \n    b. If a Derived Work is distributed under a different license, that\n       Derived Work must provide sufficient documentation as part of\n       itself to allow each recipient of that Derived Work to honor the \n       restrictions in Clause 6 above, concerning changes from the Work.\n\n11. This license places no restrictions on works that are unrelated to\nthe Work, nor does this license place any restrictions on aggregating\nsuch works with the Work by any means.\n\n12.  Nothing in this license is intended to, or may be used to, prevent\ncomplete compliance by all parties with all applicable laws.\n\n\nNO WARRANTY\n===========\n\nThere is no warranty for the Work.  Except when otherwise stated in\nwriting, the Copyright Holder provides the Work `as is', without\nwarranty of any kind, either expressed or implied, including, but not\nlimited to, the implied warranties of merchantability and fitness for a\nparticular purpose.  The entire risk as to the quality and performance\nof the Work is with you.  Should the Work prove defective, you assume\nthe cost of all necessary servicing, repair, or correction.\n\nIn no event unless required by applicable law or agreed to in writing\nwill The Copyright Holder, or any author named in the components of the\nWork, or any other party who may distribute and/or modify the Work as\npermitted above, be liable to you for damages, including any general,\nspecial, incidental or consequential damages arising out of any use of\nthe Work or out of inability to use the Work (including, but not limited\nto, loss of data, data being rendered inaccurate, or losses sustained by\nanyone as a result of any failure of the Work to operate with any other\nprograms), even if the Copyright Holder or said author or said other\nparty has been advised of the possibility of such damages.\n\n\nMAINTENANCE OF THE WORK\n=======================\n\nThe Work has the status `author-maintained' if the Copyright Holder\nexplicitly and prominently states near the primary copyright notice in\nthe Work that the Work can only be maintained by the Copyright Holder\nor simply that it is `author-maintained'.\n\nThe Work has the status `maintained' if there is a Current Maintainer\nwho has indicated in the Work that they are willing to receive error\nreports for the Work (for example, by supplying a valid e-mail\naddress). It is not required for the Current Maintainer to acknowledge\nor act upon these error reports.\n\nThe Work changes from status `maintained' to `unmaintained' if there\nis no Current Maintainer, or the person stated to be Current\nMaintainer of the work cannot be reached through the indicated means\nof communication for a period of six months, and there are no other\nsignificant signs of active maintenance.\n\nYou can become the Current Maintainer of the Work by agreement with\nany existing Current Maintainer to take over this role.\n\nIf the Work is unmaintained, you can become the Current Maintainer of\nthe Work through the following steps:\n\n 1.  Make a reasonable attempt to trace the Current Maintainer (and\n     the Copyright Holder, if the two differ) through the means of\n     an Internet or similar search.\n\n 2.  If this search is successful, then enquire whether the Work\n     is still maintained.\n\n  a. If it is being maintained, then ask the Current Maintainer\n     to update their communication data within one month.\n     \n  b. If the search is unsuccessful or no action to resume active\n     maintenance is taken by the Current Maintainer, then announce\n     within the pertinent community your intention to take over\n     maintenance.  (If the Work is a LaTeX work, this could be\n     done, for example, by posting to comp.text.tex.)\n\n 3a. If the Current Maintainer is reachable and agrees to pass\n     maintenance of the Work to you, then this takes effect\n     immediately upon announcement.\n     \n  b. If the Current Maintainer is not reachable and the Copyright\n     Holder agrees that maintenance of the Work be passed to you,\n     then this takes effect immediately upon announcement.  \n    \n 4.  If you make an `intention announcement' as described in 2b. above\n     and after three months your intention is challenged neither by\n     the Current Maintainer nor by the Copyright Holder nor by other\n     people, then you may arrange for the Work to be changed so as\n     to name you as the (new) Current Maintainer.\n     \n 5.  If the previously unreachable Current Maintainer becomes\n     reachable once more within three months of a change completed\n     under the terms of 3b) or 4), then that Current Maintainer must\n     become or remain the Current Maintainer upon request provided\n     they then update their communication data within one month.\n\nA change in the Current Maintainer does not, of itself, alter the fact\nthat the Work is distributed under the LPPL license.\n\nIf you become the Current Maintainer of the Work, you should\nimmediately provide, within the Work, a prominent and unambiguous\nstatement of your status as Current Maintainer.  You should also\nannounce your new status to the same pertinent community as\nin 2b) above.\n\n\nWHETHER AND HOW TO DISTRIBUTE WORKS UNDER THIS LICENSE\n======================================================\n\nThis section contains important instructions, examples, and\nrecommendations for authors who are considering distributing their\nworks under this license.  These authors are addressed as `you' in\nthis section.\n\nChoosing This License or Another License\n----------------------------------------\n\nIf for any part of your work you want or need to use *distribution*\nconditions that differ significantly from those in this license, then\ndo not refer to this license anywhere in your work but, instead,\ndistribute your work under a different license.  You may use the text\nof this license as a model for your own license, but your license\nshould not refer to the LPPL or otherwise give the impression that\nyour work is distributed under the LPPL.\n\nThe document `modguide.tex' in the base LaTeX distribution explains\nthe motivation behind the conditions of this license.  It explains,\nfor example, why distributing LaTeX under the GNU General Public\nLicense (GPL) was considered inappropriate.  Even if your work is\nunrelated to LaTeX, the discussion in `modguide.tex' may still be\nrelevant, and authors intending to distribute their works under any\nlicense are encouraged to read it.\n\nA Recommendation on Modification Without Distribution\n-----------------------------------------------------\n\nIt is wise never to modify a component of the Work, even for your own\npersonal use, without also meeting the above conditions for\ndistributing the modified component.  While you might intend that such\nmodifications will never be distributed, often this will happen by\naccident -- you may forget that you have modified that component; or\nit may not occur to you when allowing others to access the modified\nversion that you are thus distributing it and violating the conditions\nof this license in ways that could have legal implications and, worse,\ncause problems for the community.  It is therefore usually in your\nbest interest to keep your copy of the Work identical with the public\none.  Many works provide ways to control the behavior of that work\nwithout altering any of its licensed components.\n\nHow to Use This License\n-----------------------\n\nTo use this license, place in each of the components of your work both\nan explicit copyright notice including your name and the year the work\nwas authored and/or last substantially modified.  Include also a\nstatement that the distribution and/or modification of that\ncomponent is constrained by the conditions in this license.\n\nHere is an example of such a notice and statement:\n\n  %% pig.dtx\n  %% Copyright 2005 M. Y. Name\n  %\n  % This work may be distributed and/or modified under the\n  % conditions of the LaTeX Project Public License, either version 1.3\n  % of this license or (at your option) any later version.\n  % The latest version of this license is in\n  %   http://www.latex-project.org/lppl.txt\n  % and version 1.3 or later is part of all distributions of LaTeX\n  % version 2005/12/01 or later.\n  %\n  % This work has the LPPL maintenance status `maintained'.\n  % \n  % The Current Maintainer of this work is M. Y. Name.\n  %\n  % This work consists of the files pig.dtx and pig.ins\n  % and the derived file pig.sty.\n\nGiven such a notice and statement in a file, the conditions\ngiven in this license document would apply, with the `Work' referring\nto the three files `pig.dtx', `pig.ins', and `pig.sty' (the last being\ngenerated from `pig.dtx' using `pig.ins'), the `Base Interpreter'\nreferring to any `LaTeX-Format', and both `Copyright Holder' and\n`Current Maintainer' referring to the person `M. Y. Name'.\n\nIf you do not want the Maintenance section of LPPL to apply to your\nWork, change `maintained' above into `author-maintained'.  \nHowever, we recommend that you use `maintained', as the Maintenance\nsection was added in order to ensure that your Work remains useful to\nthe community even when you can no longer maintain and support it\nyourself.\n\nDerived Works That Are Not Replacements\n---------------------------------------\n\nSeveral clauses of the LPPL specify means to provide reliability and\nstability for the user community. They therefore concern themselves\nwith the case that a Derived Work is intended to be used as a\n(compatible or incompatible) replacement of the original Work. If\nthis is not the case (e.g., if a few lines of code are reused for a\ncompletely different task), then clauses 6b and 6d shall not apply.\n\n\nImportant Recommendations\n-------------------------\n\n Defining What Constitutes the Work\n\n   The LPPL requires that distributions of the Work contain all the\n   files of the Work.  It is therefore important that you provide a\n   way for the licensee to determine which files constitute the Work.\n   This could, for example, be achieved by explicitly listing all the\n   files of the Work near the copyright notice of each file or by\n   using a line such as:\n\n    % This work consists of all files listed in manifest.txt.\n   \n   in that place.  In the absence of an unequivocal list it might be\n   impossible for the licensee to determine what is considered by you\n   to comprise the Work and, in such a case, the licensee would be\n   entitled to make reasonable conjectures as to which files comprise\n   the Work.\n" +
+	"", etag: `"5xO8ZY8R6do="`})
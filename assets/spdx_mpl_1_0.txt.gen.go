@@ -0,0 +1,7 @@
+// AUTOMATICALLY GENERATED FILE. DO NOT EDIT.
+
+package assets
+
+var spdx_mpl_1_0 = txt(asset{Name: "spdx_mpl_1_0.txt", Content: "" +
+	"---\ntitle: Mozilla Public License 1.0\nspdx-id: MPL-1.0\nsource: https://spdx.org/licenses/MPL-1.0.html\nosi-approved: true\nfsf-libre: false\n---\n\nMOZILLA PUBLIC LICENSE\n\nVersion 1.0\n\n1. Definitions. \n\n1.1. ``Contributor&apos;&apos; means each entity that creates or contributes\nto the creation of Modifications.\n\n1.2. ``Contributor Version&apos;&apos; means the combination of the Original\nCode, prior Modifications used by a Contributor, and the Modifications made by\nthat particular Contributor.\n\n1.3. ``Covered Code&apos;&apos; means the Original Code or Modifications or\nthe combination of the Original Code and Modifications, in each case including\nportions thereof.\n\n1.4. ``Electronic Distribution Mechanism&apos;&apos; means a mechanism\ngenerally accepted in the software development community for the electronic\ntransfer of data.\n\n1.5. ``Executable&apos;&apos; means Covered Code in any form other than Source\nCode.\n\n1.6. ``Initial Developer&apos;&apos; means the individual or entity identified\nas the Initial Developer in the Source Code notice required by Exhibit A.\n\n1.7. ``Larger Work&apos;&apos; means a work which combines Covered Code or\nportions thereof with code not governed by the terms of this License.\n\n1.8. ``License&apos;&apos; means this document.\n\n1.9. ``Modifications&apos;&apos; means any addition to or deletion from the\nsubstance or structure of either the Original Code or any previous\nModifications. When Covered Code is released as a series of files, a\nModification is:\n\nA. Any addition to or deletion from the contents of a file containing Original\nCode or previous Modifications.\n\nB. Any new file that contains any part of the Original Code or previous\nModifications.\n\n1.10. ``Original Code&apos;&apos; means Source Code of computer software code\nwhich is described in the Source Code notice required by Exhibit A as Original\nCode, and which, at the time of its release under this License is not already\nCovered Code governed by this License.\n\n1.11. ``Source Code&apos;&apos; means the preferred form of the Covered Code\nfor making modifications to it, including all modules it contains, plus any\nassociated interface definition files, scripts used to control compilation and\ninstallation of an Executable, or a list of source code differential\ncomparisons against either the Original Code or another well known, available\nCovered Code of the Contributor&apos;s choice. The Source Code can be in a\ncompressed or archival form, provided the appropriate decompression or de-\narchiving software is widely available for no charge.\n\n1.12. ``You&apos;&apos; means an individual or a legal entity exercising\nrights under, and complying with all of the terms of, this License or a future\nversion of this License issued under Section 6.1. For legal entities,\n``You&apos;&apos; includes any entity which controls, is controlled by, or is\nunder common control with You. For purposes of this definition,\n``control&apos;&apos; means (a) the power, direct or indirect, to cause the\ndirection or management of such entity, whether by contract or otherwise, or\n(b) ownership of fifty percent (50%) or more of the outstanding shares or\nbeneficial ownership of such entity.\n\n2. Source Code License. \n\n2.1. The Initial Developer Grant.\n\nThe Initial Developer hereby grants You a world-wide, royalty-free, non-\nexclusive license, subject to third party intellectual property claims:\n\n(a) to use, reproduce, modify, display, perform, sublicense and distribute the\nOriginal Code (or portions thereof) with or without Modifications, or as part\nof a Larger Work; and\n\n(b) under patents now or hereafter owned or controlled by Initial Developer,\nto make, have made, use and sell (``Utilize&apos;&apos;) the Original Code (or\nportions thereof), but solely to the extent that any such patent is reasonably\nnecessary to enable You to Utilize the Original Code (or portions thereof) and\nnot to any greater extent that may be necessary to Utilize further\nModifications or combinations.\n\n2.2. Contributor Grant.\n\nEach Contributor hereby grants You a world-wide, royalty-free, non-exclusive\nlicense, subject to third party intellectual property claims:\n\n(a) to use, reproduce, modify, display, perform, sublicense and distribute the\nModifications created by such Contributor (or portions thereof) either on an\nunmodified basis, with other Modifications, as Covered Code or as part of a\nLarger Work; and\n\n(b) under patents now or hereafter owned or controlled by Contributor, to\nUtilize the Contributor Version (or portions thereof), but solely to the\nextent that any such patent is reasonably necessary to enable You to Utilize\nthe Contributor Version (or portions thereof), and not to any greater extent\nthat may be necessary to Utilize further Modifications or combinations.\n\n3. Distribution Obligations. \n\n3.1. Application of License.\n\nThe Modifications which You create or to which You contribute are governed by\nthe terms of this License, including without limitation Section 2.2. The\nSource Code version of Covered Code may be distributed only under the terms of\nthis License or a future version of this License released under Section 6.1,\nand You must include a copy of this License with every copy of the Source Code\nYou distribute. You may not offer or impose any terms on any Source Code\nversion that alters or restricts the applicable version of this License or the\nrecipients&apos; rights hereunder. However, You may include an additional\ndocument offering the additional rights described in Section 3.5.\n\n3.2. Availability of Source Code.\n\nAny Modification which You create or to which You contribute must be made\navailable in Source Code form under the terms of this License either on the\nsame media as an Executable version or via an accepted Electronic Distribution\nMechanism to anyone to whom you made an Executable version available; and if\nmade available via Electronic Distribution Mechanism, must remain available\nfor at least twelve (12) months after the date it initially became available,\nor at least six (6) months after a subsequent version of that particular\nModification has been made available to such recipients. You are responsible\nfor ensuring that the Source Code version remains available even if the\nElectronic Distribution Mechanism is maintained by a third party.\n\n3.3. Description of Modifications.\n\nYou must cause all Covered Code to which you contribute to contain a file\ndocumenting the changes You made to create that Covered Code and the date of\nany change. You must include a prominent statement that the Modification is\nderived, directly or indirectly, from Original Code provided by the Initial\nDeveloper and including the name of the Initial Developer in (a) the Source\nCode, and (b) in any notice in an Executable version or related documentation\nin which You describe the origin or ownership of the Covered Code.\n\n3.4. Intellectual Property Matters\n\n(a) Third Party Claims.\n\nIf You have knowledge that a party claims an intellectual property right in\nparticular functionality or code (or its utilization under this License), you\nmust include a text file with the source code distribution titled\n``LEGAL&apos;&apos; which describes the claim and the party making the claim\nin sufficient detail that a recipient will know whom to contact. If you obtain\nsuch knowledge after You make Your Modification available as described in\nSection 3.2, You shall promptly modify the LEGAL file in all copies You make\navailable thereafter and shall take other steps (such as notifying appropriate\nmailing lists or newsgroups) reasonably calculated to inform those who\nreceived the Covered Code that new knowledge has been obtained.\n\n(b) Contributor APIs.\n\nIf Your Modification is an application programming interface and You own or\ncontrol patents which are reasonably necessary to implement that API, you must\nalso include this information in the LEGAL file.\n\n3.5. Required Notices.\n\nYou must duplicate the notice in Exhibit A in each file of the Source Code,\nand this License in any documentation for the Source Code, where You describe\nrecipients&apos; rights relating to Covered Code. If You created one or more\nModification(s), You may add your name as a Contributor to the notice\ndescribed in Exhibit A. If it is not possible to put such notice in a\nparticular Source Code file due to its structure, then you must include such\nnotice in a location (such as a relevant directory file) where a user would be\nlikely to look for such a notice. You may choose to offer, and to charge a fee\nfor, warranty, support, indemnity or liability obligations to one or more\nrecipients of Covered Code. However, You may do so only on Your own behalf,\nand not on behalf of the Initial Developer or any Contributor. You must make\nit absolutely clear than any such warranty, support, indemnity or liability\nobligation is offered by You alone, and You hereby agree to indemnify the\nInitial Developer and every Contributor for any liability incurred by the\nInitial Developer or such Contributor as a result of warranty, support,\nindemnity or liability terms You offer.\n\n3.6. Distribution of Executable Versions.\n\nYou may distribute Covered Code in Executable form only if the requirements of\nSection 3.1-3.5 have been met for that Covered Code, and if You include a\nnotice stating that the Source Code version of the Covered Code is available\nunder the terms of this License, including a description of how and where You\nhave fulfilled the obligations of Section 3.2. The notice must be\nconspicuously included in any notice in an Executable version, related\ndocumentation or collateral in which You describe recipients&apos; rights\nrelating to the Covered Code. You may distribute the Executable version of\nCovered Code under a license of Your choice, which may contain terms different\nfrom this License, provided that You are in compliance with the terms of this\nLicense and that the license for the Executable version does not attempt to\nlimit or alter the recipient&apos;s rights in the Source Code version from the\nrights set forth in this License. If You distribute the Executable version\nunder a different license You must make it absolutely clear that any terms\nwhich differ from this License are offered by You alone, not by the Initial\nDeveloper or any Contributor. You hereby agree to indemnify the Initial\nDeveloper and every Contributor for any liability incurred by the Initial\nDeveloper or such Contributor as a result of any such terms You offer.\n\n3.7. Larger Works.\n\nYou may create a Larger Work by combining Covered Code with other code not\ngoverned by the terms of this License and distribute the Larger Work as a\nsingle product. In such a case, You must make sure the requirements of this\nLicense are fulfilled for the Covered Code.\n\n4. Inability to Comply Due to Statute or Regulation.   \nIf it is impossible for You to comply with any of the terms of this License\nwith respect to some or all of the Covered Code due to statute or regulation\nthen You must: (a) comply with the terms of this License to the maximum extent\npossible; and (b) describe the limitations and the code they affect. Such\ndescription must be included in the LEGAL file described in Section 3.4 and\nmust be included with all distributions of the Source Code. Except to the\nextent prohibited by statute or regulation, such description must be\nsufficiently detailed for a recipient of ordinary skill to be able to\nunderstand it.\n\n5. Application of this License.   \nThis License applies to code to which the Initial Developer has attached the\nnotice in Exhibit A, and to related Covered Code.\n\n6. Versions of the License. \n\n6.1. New Versions.\n\nNetscape Communications Corporation (``Netscape&apos;&apos;) may publish\nrevised and/or new versions of the License from time to time. Each version\nwill be given a distinguishing version number.\n\n6.2. Effect of New Versions.\n\nOnce Covered Code has been published under a particular version of the\nLicense, You may always continue to use it under the terms of that version.\nYou may also choose to use such Covered Code under the terms of any subsequent\nversion of the License published by Netscape. No one other than Netscape has\nthe right to modify the terms applicable to Covered Code created under this\nLicense.\n\n6.3. Derivative Works.\n\nIf you create or use a modified version of this License (which you may only do\nin order to apply it to code which is not already Covered Code governed by\nthis License), you must (a) rename Your license so that the phrases\n``Mozilla&apos;&apos;, ``MOZILLAPL&apos;&apos;, ``MOZPL&apos;&apos;,\n``Netscape&apos;&apos;, ``NPL&apos;&apos; or any confusingly similar phrase do\nnot appear anywhere in your license and (b) otherwise make it clear that your\nversion of the license contains terms which differ from the Mozilla Public\nLicense and Netscape Public License. (Filling in the name of the Initial\nDeveloper, Original Code or Contributor in the notice described in Exhibit A\nshall not of themselves be deemed to be modifications of this License.)\n\n7. DISCLAIMER OF WARRANTY.   \nCOVERED CODE IS PROVIDED UNDER THIS LICENSE ON AN ``AS IS&apos;&apos; BASIS,\nWITHOUT WARRANTY OF ANY KIND, EITHER EXPRESSED OR IMPLIED, INCLUDING, WITHOUT\nLIMITATION, WARRANTIES THAT THE COVERED CODE IS FREE OF DEFECTS, MERCHANTABLE,\nFIT FOR A PARTICULAR PURPOSE OR NON-INFRINGING. THE ENTIRE RISK AS TO THE\nQUALITY AND PERFORMANCE OF THE COVERED CODE IS WITH YOU. SHOULD ANY COVERED\nCODE PROVE DEFECTIVE IN ANY RESPECT, YOU (NOT THE INITIAL DEVELOPER OR ANY\nOTHER CONTRIBUTOR) ASSUME THE COST OF ANY NECESSARY SERVICING, REPAIR OR\nCORRECTION. THIS DISCLAIMER OF WARRANTY CONSTITUTES AN ESSENTIAL PART OF THIS\nLICENSE. NO USE OF ANY COVERED CODE IS AUTHORIZED HEREUNDER EXCEPT UNDER THIS\nDISCLAIMER.\n\n8. TERMINATION.   \nThis License and the rights granted hereunder will terminate automatically if\nYou fail to comply with terms herein and fail to cure such breach within 30\ndays of becoming aware of the breach. All sublicenses to the Covered Code\nwhich are properly granted shall survive any termination of this License.\nProvisions which, by their nature, must remain in effect beyond the\ntermination of this License shall survive.\n\n9. LIMITATION OF LIABILITY.   \nUNDER NO CIRCUMSTANCES AND UNDER NO LEGAL THEORY, WHETHER TORT (INCLUDING\nNEGLIGENCE), CONTRACT, OR OTHERWISE, SHALL THE INITIAL DEVELOPER, ANY OTHER\nCONTRIBUTOR, OR ANY DISTRIBUTOR OF COVERED CODE, OR ANY SUPPLIER OF ANY OF\nSUCH PARTIES, BE LIABLE TO YOU OR ANY OTHER PERSON FOR ANY INDIRECT, SPECIAL,\nINCIDENTAL, OR CONSEQUENTIAL DAMAGES OF ANY CHARACTER INCLUDING, WITHOUT\nLIMITATION, DAMAGES FOR LOSS OF GOODWILL, WORK STOPPAGE, COMPUTER FAILURE OR\nMALFUNCTION, OR ANY AND ALL OTHER COMMERCIAL DAMAGES OR LOSSES, EVEN IF SUCH\nPARTY SHALL HAVE BEEN INFORMED OF THE POSSIBILITY OF SUCH DAMAGES. THIS\nLIMITATION OF LIABILITY SHALL NOT APPLY TO LIABILITY FOR DEATH OR PERSONAL\nINJURY RESULTING FROM SUCH PARTY&apos;S NEGLIGENCE TO THE EXTENT APPLICABLE\nLAW PROHIBITS SUCH LIMITATION. SOME JURISDICTIONS DO NOT ALLOW THE EXCLUSION\nOR LIMITATION OF INCIDENTAL OR CONSEQUENTIAL DAMAGES, SO THAT EXCLUSION AND\nLIMITATION MAY NOT APPLY TO YOU.\n\n10. U.S. GOVERNMENT END USERS.   \nThe Covered Code is a ``commercial item,&apos;&apos; as that term is defined\nin 48 C.F.R. 2.101 (Oct. 1995), consisting of ``commercial computer\nsoftware&apos;&apos; and ``commercial computer software\ndocumentation,&apos;&apos; as such terms are used in 48 C.F.R. 12.212 (Sept.\n1995). Consistent with 48 C.F.R. 12.212 and 48 C.F.R. 227.7202-1 through\n227.7202-4 (June 1995), all U.S. Government End Users acquire Covered Code\nwith only those rights set forth herein.\n\n11. MISCELLANEOUS.   \nThis License represents the complete agreement concerning subject matter\nhereof. If any provision of this License is held to be unenforceable, such\nprovision shall be reformed only to the extent necessary to make it\nenforceable. This License shall be governed by California law provisions\n(except to the extent applicable law, if any, provides otherwise), excluding\nits conflict-of-law provisions. With respect to disputes in which at least one\nparty is a citizen of, or an entity chartered or registered to do business in,\nthe United States of America: (a) unless otherwise agreed in writing, all\ndisputes relating to this License (excepting any dispute relating to\nintellectual property rights) shall be subject to final and binding\narbitration, with the losing party paying all costs of arbitration; (b) any\narbitration relating to this Agreement shall be held in Santa Clara County,\nCalifornia, under the auspices of JAMS/EndDispute; and (c) any litigation\nrelating to this Agreement shall be subject to the jurisdiction of the Federal\nCourts of the Northern District of California, with venue lying in Santa Clara\nCounty, California, with the losing party responsible for costs, including\nwithout limitation, court costs and reasonable attorneys fees and expenses.\nThe application of the United Nations Convention on Contracts for the\nInternational Sale of Goods is expressly excluded. Any law or regulation which\nprovides that the language of a contract shall be construed against the\ndrafter shall not apply to this License.\n\n12. RESPONSIBILITY FOR CLAIMS.   \nExcept in cases where another Contributor has failed to comply with Section\n3.4, You are responsible for damages arising, directly or indirectly, out of\nYour utilization of rights under this License, based on the number of copies\nof Covered Code you made available, the revenues you received from utilizing\nsuch rights, and other relevant factors. You agree to work with affected\nparties to distribute responsibility on an equitable basis.\n\nEXHIBIT A.\n\n``The contents of this file are subject to the Mozilla Public License Version\n1.0 (the \"License\"); you may not use this file except in compliance with the\nLicense. You may obtain a copy of the License at http://www.mozilla.org/MPL/\n\nSoftware distributed under the License is distributed on an \"AS IS\" basis,\nWITHOUT WARRANTY OF ANY KIND, either express or implied. See the License for\nthe specific language governing rights and limitations under the License.\n\nThe Original Code is ______________________________________.\n\nThe Initial Developer of the Original Code is ________________________.\nPortions created by ______________________ are Copyright (C) ______\n_______________________. All Rights Reserved.\n\nContributor(s): ______________________________________.&apos;&apos;\n\n" +
+	"", etag: `"oEF2jqeTwGo="`})
@@ -0,0 +1,7 @@
+// AUTOMATICALLY GENERATED FILE. DO NOT EDIT.
+
+package assets
+
+var spdx_mpl_1_1 = txt(asset{Name: "spdx_mpl_1_1.txt", Content: "" +
+	"---\ntitle: Mozilla Public License 1.1\nspdx-id: MPL-1.1\nsource: https://spdx.org/licenses/MPL-1.1.html\nosi-approved: true\nfsf-libre: false\n---\n\nMozilla Public License Version 1.1\n\n1. Definitions. \n\n1.0.1. \"Commercial Use\" means distribution or otherwise making the Covered\nCode available to a third party.\n\n1.1. \"Contributor\" means each entity that creates or contributes to the\ncreation of Modifications.\n\n1.2. \"Contributor Version\" means the combination of the Original Code, prior\nModifications used by a Contributor, and the Modifications made by that\nparticular Contributor.\n\n1.3. \"Covered Code\" means the Original Code or Modifications or the\ncombination of the Original Code and Modifications, in each case including\nportions thereof.\n\n1.4. \"Electronic Distribution Mechanism\" means a mechanism generally accepted\nin the software development community for the electronic transfer of data.\n\n1.5. \"Executable\" means Covered Code in any form other than Source Code.\n\n1.6. \"Initial Developer\" means the individual or entity identified as the\nInitial Developer in the Source Code notice required by Exhibit A.\n\n1.7. \"Larger Work\" means a work which combines Covered Code or portions\nthereof with code not governed by the terms of this License.\n\n1.8. \"License\" means this document.\n\n1.8.1. \"Licensable\" means having the right to grant, to the maximum extent\npossible, whether at the time of the initial grant or subsequently acquired,\nany and all of the rights conveyed herein.\n\n1.9. \"Modifications\" means any addition to or deletion from the substance or\nstructure of either the Original Code or any previous Modifications. When\nCovered Code is released as a series of files, a Modification is:\n\nAny addition to or deletion from the contents of a file containing Original\nCode or previous Modifications.\n\nAny new file that contains any part of the Original Code or previous\nModifications.\n\n1.10. \"Original Code\" means Source Code of computer software code which is\ndescribed in the Source Code notice required by Exhibit A as Original Code,\nand which, at the time of its release under this License is not already\nCovered Code governed by this License.\n\n1.10.1. \"Patent Claims\" means any patent claim(s), now owned or hereafter\nacquired, including without limitation, method, process, and apparatus claims,\nin any patent Licensable by grantor.\n\n1.11. \"Source Code\" means the preferred form of the Covered Code for making\nmodifications to it, including all modules it contains, plus any associated\ninterface definition files, scripts used to control compilation and\ninstallation of an Executable, or source code differential comparisons against\neither the Original Code or another well known, available Covered Code of the\nContributor&apos;s choice. The Source Code can be in a compressed or archival\nform, provided the appropriate decompression or de-archiving software is\nwidely available for no charge.\n\n1.12. \"You\" (or \"Your\") means an individual or a legal entity exercising\nrights under, and complying with all of the terms of, this License or a future\nversion of this License issued under Section 6.1. For legal entities, \"You\"\nincludes any entity which controls, is controlled by, or is under common\ncontrol with You. For purposes of this definition, \"control\" means (a) the\npower, direct or indirect, to cause the direction or management of such\nentity, whether by contract or otherwise, or (b) ownership of more than fifty\npercent (50%) of the outstanding shares or beneficial ownership of such\nentity.\n\n2. Source Code License. \n\n2.1. The Initial Developer Grant. The Initial Developer hereby grants You a\nworld-wide, royalty-free, non-exclusive license, subject to third party\nintellectual property claims:\n\na. under intellectual property rights (other than patent or trademark)\nLicensable by Initial Developer to use, reproduce, modify, display, perform,\nsublicense and distribute the Original Code (or portions thereof) with or\nwithout Modifications, and/or as part of a Larger Work; and\n\nb. under Patents Claims infringed by the making, using or selling of Original\nCode, to make, have made, use, practice, sell, and offer for sale, and/or\notherwise dispose of the Original Code (or portions thereof).\n\nc. the licenses granted in this Section 2.1 (a) and (b) are effective on the\ndate Initial Developer first distributes Original Code under the terms of this\nLicense.\n\nd. Notwithstanding Section 2.1 (b) above, no patent license is granted: 1) for\ncode that You delete from the Original Code; 2) separate from the Original\nCode; or 3) for infringements caused by: i) the modification of the Original\nCode or ii) the combination of the Original Code with other software or\ndevices.\n\n2.2. Contributor Grant. Subject to third party intellectual property claims,\neach Contributor hereby grants You a world-wide, royalty-free, non-exclusive\nlicense\n\na. under intellectual property rights (other than patent or trademark)\nLicensable by Contributor, to use, reproduce, modify, display, perform,\nsublicense and distribute the Modifications created by such Contributor (or\nportions thereof) either on an unmodified basis, with other Modifications, as\nCovered Code and/or as part of a Larger Work; and\n\nb. under Patent Claims infringed by the making, using, or selling of\nModifications made by that Contributor either alone and/or in combination with\nits Contributor Version (or portions of such combination), to make, use, sell,\noffer for sale, have made, and/or otherwise dispose of: 1) Modifications made\nby that Contributor (or portions thereof); and 2) the combination of\nModifications made by that Contributor with its Contributor Version (or\nportions of such combination).\n\nc. the licenses granted in Sections 2.2 (a) and 2.2 (b) are effective on the\ndate Contributor first makes Commercial Use of the Covered Code.\n\nd. Notwithstanding Section 2.2 (b) above, no patent license is granted: 1) for\nany code that Contributor has deleted from the Contributor Version; 2)\nseparate from the Contributor Version; 3) for infringements caused by: i)\nthird party modifications of Contributor Version or ii) the combination of\nModifications made by that Contributor with other software (except as part of\nthe Contributor Version) or other devices; or 4) under Patent Claims infringed\nby Covered Code in the absence of Modifications made by that Contributor.\n\n3. Distribution Obligations. \n\n3.1. Application of License. The Modifications which You create or to which\nYou contribute are governed by the terms of this License, including without\nlimitation Section 2.2. The Source Code version of Covered Code may be\ndistributed only under the terms of this License or a future version of this\nLicense released under Section 6.1, and You must include a copy of this\nLicense with every copy of the Source Code You distribute. You may not offer\nor impose any terms on any Source Code version that alters or restricts the\napplicable version of this License or the recipients&apos; rights hereunder.\nHowever, You may include an additional document offering the additional rights\ndescribed in Section 3.5.\n\n3.2. Availability of Source Code. Any Modification which You create or to\nwhich You contribute must be made available in Source Code form under the\nterms of this License either on the same media as an Executable version or via\nan accepted Electronic Distribution Mechanism to anyone to whom you made an\nExecutable version available; and if made available via Electronic\nDistribution Mechanism, must remain available for at least twelve (12) months\nafter the date it initially became available, or at least six (6) months after\na subsequent version of that particular Modification has been made available\nto such recipients. You are responsible for ensuring that the Source Code\nversion remains available even if the Electronic Distribution Mechanism is\nmaintained by a third party.\n\n3.3. Description of Modifications. You must cause all Covered Code to which\nYou contribute to contain a file documenting the changes You made to create\nthat Covered Code and the date of any change. You must include a prominent\nstatement that the Modification is derived, directly or indirectly, from\nOriginal Code provided by the Initial Developer and including the name of the\nInitial Developer in (a) the Source Code, and (b) in any notice in an\nExecutable version or related documentation in which You describe the origin\nor ownership of the Covered Code.\n\n3.4. Intellectual Property Matters\n\n(a) Third Party Claims\n\nIf Contributor has knowledge that a license under a third party&apos;s\nintellectual property rights is required to exercise the rights granted by\nsuch Contributor under Sections 2.1 or 2.2, Contributor must include a text\nfile with the Source Code distribution titled \"LEGAL\" which describes the\nclaim and the party making the claim in sufficient detail that a recipient\nwill know whom to contact. If Contributor obtains such knowledge after the\nModification is made available as described in Section 3.2, Contributor shall\npromptly modify the LEGAL file in all copies Contributor makes available\nthereafter and shall take other steps (such as notifying appropriate mailing\nlists or newsgroups) reasonably calculated to inform those who received the\nCovered Code that new knowledge has been obtained.\n\n(b) Contributor APIs\n\nIf Contributor&apos;s Modifications include an application programming\ninterface and Contributor has knowledge of patent licenses which are\nreasonably necessary to implement that API, Contributor must also include this\ninformation in the LEGAL file.\n\n(c) Representations.\n\nContributor represents that, except as disclosed pursuant to Section 3.4 (a)\nabove, Contributor believes that Contributor&apos;s Modifications are\nContributor&apos;s original creation(s) and/or Contributor has sufficient\nrights to grant the rights conveyed by this License.\n\n3.5. Required Notices. You must duplicate the notice in Exhibit A in each file\nof the Source Code. If it is not possible to put such notice in a particular\nSource Code file due to its structure, then You must include such notice in a\nlocation (such as a relevant directory) where a user would be likely to look\nfor such a notice. If You created one or more Modification(s) You may add your\nname as a Contributor to the notice described in Exhibit A. You must also\nduplicate this License in any documentation for the Source Code where You\ndescribe recipients&apos; rights or ownership rights relating to Covered Code.\nYou may choose to offer, and to charge a fee for, warranty, support, indemnity\nor liability obligations to one or more recipients of Covered Code. However,\nYou may do so only on Your own behalf, and not on behalf of the Initial\nDeveloper or any Contributor. You must make it absolutely clear than any such\nwarranty, support, indemnity or liability obligation is offered by You alone,\nand You hereby agree to indemnify the Initial Developer and every Contributor\nfor any liability incurred by the Initial Developer or such Contributor as a\nresult of warranty, support, indemnity or liability terms You offer.\n\n3.6. Distribution of Executable Versions. You may distribute Covered Code in\nExecutable form only if the requirements of Sections 3.1, 3.2, 3.3, 3.4 and\n3.5 have been met for that Covered Code, and if You include a notice stating\nthat the Source Code version of the Covered Code is available under the terms\nof this License, including a description of how and where You have fulfilled\nthe obligations of Section 3.2. The notice must be conspicuously included in\nany notice in an Executable version, related documentation or collateral in\nwhich You describe recipients&apos; rights relating to the Covered Code. You\nmay distribute the Executable version of Covered Code or ownership rights\nunder a license of Your choice, which may contain terms different from this\nLicense, provided that You are in compliance with the terms of this License\nand that the license for the Executable version does not attempt to limit or\nalter the recipient&apos;s rights in the Source Code version from the rights\nset forth in this License. If You distribute the Executable version under a\ndifferent license You must make it absolutely clear that any terms which\ndiffer from this License are offered by You alone, not by the Initial\nDeveloper or any Contributor. You hereby agree to indemnify the Initial\nDeveloper and every Contributor for any liability incurred by the Initial\nDeveloper or such Contributor as a result of any such terms You offer.\n\n3.7. Larger Works. You may create a Larger Work by combining Covered Code with\nother code not governed by the terms of this License and distribute the Larger\nWork as a single product. In such a case, You must make sure the requirements\nof this License are fulfilled for the Covered Code.\n\n4. Inability to Comply Due to Statute or Regulation. \n\nIf it is impossible for You to comply with any of the terms of this License\nwith respect to some or all of the Covered Code due to statute, judicial\norder, or regulation then You must: (a) comply with the terms of this License\nto the maximum extent possible; and (b) describe the limitations and the code\nthey affect. Such description must be included in the LEGAL file described in\nSection 3.4 and must be included with all distributions of the Source Code.\nExcept to the extent prohibited by statute or regulation, such description\nmust be sufficiently detailed for a recipient of ordinary skill to be able to\nunderstand it.\n\n5. Application of this License.   \nThis License applies to code to which the Initial Developer has attached the\nnotice in Exhibit A and to related Covered Code.\n\n6. Versions of the License. \n\n6.1. New Versions\n\nNetscape Communications Corporation (\"Netscape\") may publish revised and/or\nnew versions of the License from time to time. Each version will be given a\ndistinguishing version number.\n\n6.2. Effect of New Versions\n\nOnce Covered Code has been published under a particular version of the\nLicense, You may always continue to use it under the terms of that version.\nYou may also choose to use such Covered Code under the terms of any subsequent\nversion of the License published by Netscape. No one other than Netscape has\nthe right to modify the terms applicable to Covered Code created under this\nLicense.\n\n6.3. Derivative Works\n\nIf You create or use a modified version of this License (which you may only do\nin order to apply it to code which is not already Covered Code governed by\nthis License), You must (a) rename Your license so that the phrases \"Mozilla\",\n\"MOZILLAPL\", \"MOZPL\", \"Netscape\", \"MPL\", \"NPL\" or any confusingly similar\nphrase do not appear in your license (except to note that your license differs\nfrom this License) and (b) otherwise make it clear that Your version of the\nlicense contains terms which differ from the Mozilla Public License and\nNetscape Public License. (Filling in the name of the Initial Developer,\nOriginal Code or Contributor in the notice described in Exhibit A shall not of\nthemselves be deemed to be modifications of this License.)\n\n7. DISCLAIMER OF WARRANTY   \nCOVERED CODE IS PROVIDED UNDER THIS LICENSE ON AN \"AS IS\" BASIS, WITHOUT\nWARRANTY OF ANY KIND, EITHER EXPRESSED OR IMPLIED, INCLUDING, WITHOUT\nLIMITATION, WARRANTIES THAT THE COVERED CODE IS FREE OF DEFECTS, MERCHANTABLE,\nFIT FOR A PARTICULAR PURPOSE OR NON-INFRINGING. THE ENTIRE RISK AS TO THE\nQUALITY AND PERFORMANCE OF THE COVERED CODE IS WITH YOU. SHOULD ANY COVERED\nCODE PROVE DEFECTIVE IN ANY RESPECT, YOU (NOT THE INITIAL DEVELOPER OR ANY\nOTHER CONTRIBUTOR) ASSUME THE COST OF ANY NECESSARY SERVICING, REPAIR OR\nCORRECTION. THIS DISCLAIMER OF WARRANTY CONSTITUTES AN ESSENTIAL PART OF THIS\nLICENSE. NO USE OF ANY COVERED CODE IS AUTHORIZED HEREUNDER EXCEPT UNDER THIS\nDISCLAIMER.\n\n8. Termination \n\n8.1. This License and the rights granted hereunder will terminate\nautomatically if You fail to comply with terms herein and fail to cure such\nbreach within 30 days of becoming aware of the breach. All sublicenses to the\nCovered Code which are properly granted shall survive any termination of this\nLicense. Provisions which, by their nature, must remain in effect beyond the\ntermination of this License shall survive.\n\n8.2. If You initiate litigation by asserting a patent infringement claim\n(excluding declatory judgment actions) against Initial Developer or a\nContributor (the Initial Developer or Contributor against whom You file such\naction is referred to as \"Participant\") alleging that:\n\na. such Participant&apos;s Contributor Version directly or indirectly\ninfringes any patent, then any and all rights granted by such Participant to\nYou under Sections 2.1 and/or 2.2 of this License shall, upon 60 days notice\nfrom Participant terminate prospectively, unless if within 60 days after\nreceipt of notice You either: (i) agree in writing to pay Participant a\nmutually agreeable reasonable royalty for Your past and future use of\nModifications made by such Participant, or (ii) withdraw Your litigation claim\nwith respect to the Contributor Version against such Participant. If within 60\ndays of notice, a reasonable royalty and payment arrangement are not mutually\nagreed upon in writing by the parties or the litigation claim is not\nwithdrawn, the rights granted by Participant to You under Sections 2.1 and/or\n2.2 automatically terminate at the expiration of the 60 day notice period\nspecified above.\n\nb. any software, hardware, or device, other than such Participant&apos;s\nContributor Version, directly or indirectly infringes any patent, then any\nrights granted to You by such Participant under Sections 2.1(b) and 2.2(b) are\nrevoked effective as of the date You first made, used, sold, distributed, or\nhad made, Modifications made by that Participant.\n\n8.3. If You assert a patent infringement claim against Participant alleging\nthat such Participant&apos;s Contributor Version directly or indirectly\ninfringes any patent where such claim is resolved (such as by license or\nsettlement) prior to the initiation of patent infringement litigation, then\nthe reasonable value of the licenses granted by such Participant under\nSections 2.1 or 2.2 shall be taken into account in determining the amount or\nvalue of any payment or license.\n\n8.4. In the event of termination under Sections 8.1 or 8.2 above, all end user\nlicense agreements (excluding distributors and resellers) which have been\nvalidly granted by You or any distributor hereunder prior to termination shall\nsurvive termination.\n\n9. LIMITATION OF LIABILITY   \nUNDER NO CIRCUMSTANCES AND UNDER NO LEGAL THEORY, WHETHER TORT (INCLUDING\nNEGLIGENCE), CONTRACT, OR OTHERWISE, SHALL YOU, THE INITIAL DEVELOPER, ANY\nOTHER CONTRIBUTOR, OR ANY DISTRIBUTOR OF COVERED CODE, OR ANY SUPPLIER OF ANY\nOF SUCH PARTIES, BE LIABLE TO ANY PERSON FOR ANY INDIRECT, SPECIAL,\nINCIDENTAL, OR CONSEQUENTIAL DAMAGES OF ANY CHARACTER INCLUDING, WITHOUT\nLIMITATION, DAMAGES FOR LOSS OF GOODWILL, WORK STOPPAGE, COMPUTER FAILURE OR\nMALFUNCTION, OR ANY AND ALL OTHER COMMERCIAL DAMAGES OR LOSSES, EVEN IF SUCH\nPARTY SHALL HAVE BEEN INFORMED OF THE POSSIBILITY OF SUCH DAMAGES. THIS\nLIMITATION OF LIABILITY SHALL NOT APPLY TO LIABILITY FOR DEATH OR PERSONAL\nINJURY RESULTING FROM SUCH PARTY&apos;S NEGLIGENCE TO THE EXTENT APPLICABLE\nLAW PROHIBITS SUCH LIMITATION. SOME JURISDICTIONS DO NOT ALLOW THE EXCLUSION\nOR LIMITATION OF INCIDENTAL OR CONSEQUENTIAL DAMAGES, SO THIS EXCLUSION AND\nLIMITATION MAY NOT APPLY TO YOU.\n\n10. U.S. government end users   \nThe Covered Code is a \"commercial item,\" as that term is defined in 48 C.F.R.\n2.101 (Oct. 1995), consisting of \"commercial computer software\" and\n\"commercial computer software documentation,\" as such terms are used in 48\nC.F.R. 12.212 (Sept. 1995). Consistent with 48 C.F.R. 12.212 and 48 C.F.R.\n227.7202-1 through 227.7202-4 (June 1995), all U.S. Government End Users\nacquire Covered Code with only those rights set forth herein.\n\n11. Miscellaneous   \nThis License represents the complete agreement concerning subject matter\nhereof. If any provision of this License is held to be unenforceable, such\nprovision shall be reformed only to the extent necessary to make it\nenforceable. This License shall be governed by California law provisions\n(except to the extent applicable law, if any, provides otherwise), excluding\nits conflict-of-law provisions. With respect to disputes in which at least one\nparty is a citizen of, or an entity chartered or registered to do business in\nthe United States of America, any litigation relating to this License shall be\nsubject to the jurisdiction of the Federal Courts of the Northern District of\nCalifornia, with venue lying in Santa Clara County, California, with the\nlosing party responsible for costs, including without limitation, court costs\nand reasonable attorneys&apos; fees and expenses. The application of the\nUnited Nations Convention on Contracts for the International Sale of Goods is\nexpressly excluded. Any law or regulation which provides that the language of\na contract shall be construed against the drafter shall not apply to this\nLicense.\n\n12. Responsibility for claims   \nAs between Initial Developer and the Contributors, each party is responsible\nfor claims and damages arising, directly or indirectly, out of its utilization\nof rights under this License and You agree to work with Initial Developer and\nContributors to distribute such responsibility on an equitable basis. Nothing\nherein is intended or shall be deemed to constitute any admission of\nliability.\n\n13. Multiple-licensed code   \nInitial Developer may designate portions of the Covered Code as \"Multiple-\nLicensed\". \"Multiple-Licensed\" means that the Initial Developer permits you to\nutilize portions of the Covered Code under Your choice of the MPL or the\nalternative licenses, if any, specified by the Initial Developer in the file\ndescribed in Exhibit A.\n\nExhibit A - Mozilla Public License.\n\n\"The contents of this file are subject to the Mozilla Public License Version\n1.1 (the \"License\"); you may not use this file except in compliance with the\nLicense. You may obtain a copy of the License at http://www.mozilla.org/MPL/\n\nSoftware distributed under the License is distributed on an \"AS IS\" basis,\nWITHOUT WARRANTY OF ANY KIND, either express or implied. See the License for\nthe specific language governing rights and limitations under the License.\n\nThe Original Code is ______________________________________.\n\nThe Initial Developer of the Original Code is ________________________.\n\nPortions created by ______________________ are Copyright (C) ______\n\n_______________________. All Rights Reserved.\n\nContributor(s): ______________________________________.\n\nAlternatively, the contents of this file may be used under the terms of the\n_____ license (the \"[___] License\"), in which case the provisions of [______]\nLicense are applicable instead of those above. If you wish to allow use of\nyour version of this file only under the terms of the [____] License and not\nto allow others to use your version of this file under the MPL, indicate your\ndecision by deleting the provisions above and replace them with the notice and\nother provisions required by the [___] License. If you do not delete the\nprovisions above, a recipient may use your version of this file under either\nthe MPL or the [___] License.\"\n\nNOTE: The text of this Exhibit A may differ slightly from the text of the\nnotices in the Source Code files of the Original Code. You should use the text\nof this Exhibit A rather than the text found in the Original Code Source Code\nfor Your Modifications.\n\n" +
+	"", etag: `"vHyed1+87nk="`})
@@ -0,0 +1,7 @@
+// AUTOMATICALLY GENERATED FILE. DO NOT EDIT.
+
+package assets
+
+var spdx_npl_1_0 = txt(asset{Name: "spdx_npl_1_0.txt", Content: "" +
+	"---\ntitle: Netscape Public License v1.0\nspdx-id: NPL-1.0\nsource: https://spdx.org/licenses/NPL-1.0.html\nosi-approved: false\nfsf-libre: false\n---\n\nNETSCAPE PUBLIC LICENSE\u2028Version 1.0\n\n1. Definitions. \n\n1.1. ``Contributor&apos;&apos; means each entity that creates or contributes\nto the creation of Modifications.\n\n1.2. ``Contributor Version&apos;&apos; means the combination of the Original\nCode, prior Modifications used by a Contributor, and the Modifications made by\nthat particular Contributor.\n\n1.3. ``Covered Code&apos;&apos; means the Original Code or Modifications or\nthe combination of the Original Code and Modifications, in each case including\nportions thereof.\n\n1.4. ``Electronic Distribution Mechanism&apos;&apos; means a mechanism\ngenerally accepted in the software development community for the electronic\ntransfer of data.\n\n1.5. ``Executable&apos;&apos; means Covered Code in any form other than Source\nCode.\n\n1.6. ``Initial Developer&apos;&apos; means the individual or entity identified\nas the Initial Developer in the Source Code notice required byExhibit A.\n\n1.7. ``Larger Work&apos;&apos; means a work which combines Covered Code or\nportions thereof with code not governed by the terms of this License.\n\n1.8. ``License&apos;&apos; means this document.\n\n1.9. ``Modifications&apos;&apos; means any addition to or deletion from the\nsubstance or structure of either the Original Code or any previous\nModifications. When Covered Code is released as a series of files, a\nModification is:\n\nA. Any addition to or deletion from the contents of a file containing Original\nCode or previous Modifications.\u2028 B. Any new file that contains any part of the\nOriginal Code or previous Modifications.\n\n1.10. ``Original Code&apos;&apos; means Source Code of computer software code\nwhich is described in the Source Code notice required byExhibit A as Original\nCode, and which, at the time of its release under this License is not already\nCovered Code governed by this License.\n\n1.11. ``Source Code&apos;&apos; means the preferred form of the Covered Code\nfor making modifications to it, including all modules it contains, plus any\nassociated interface definition files, scripts used to control compilation and\ninstallation of an Executable, or a list of source code differential\ncomparisons against either the Original Code or another well known, available\nCovered Code of the Contributor&apos;s choice. The Source Code can be in a\ncompressed or archival form, provided the appropriate decompression or de-\narchiving software is widely available for no charge.\n\n1.12. ``You&apos;&apos; means an individual or a legal entity exercising\nrights under, and complying with all of the terms of, this License or a future\nversion of this License issued under Section 6.1. For legal entities,\n``You&apos;&apos; includes any entity which controls, is controlled by, or is\nunder common control with You. For purposes of this definition,\n``control&apos;&apos; means (a) the power, direct or indirect, to cause the\ndirection or management of such entity, whether by contract or otherwise, or\n(b) ownership of fifty percent (50%) or more of the outstanding shares or\nbeneficial ownership of such entity.\n\n2. Source Code License. \n\n2.1. The Initial Developer Grant. The Initial Developer hereby grants You a\nworld-wide, royalty-free, non-exclusive license, subject to third party\nintellectual property claims:\n\na) to use, reproduce, modify, display, perform, sublicense and distribute the\nOriginal Code (or portions thereof) with or without Modifications, or as part\nof a Larger Work; and\u2028 (b) under patents now or hereafter owned or controlled\nby Initial Developer, to make, have made, use and sell (``Utilize&apos;&apos;)\nthe Original Code (or portions thereof), but solely to the extent that any\nsuch patent is reasonably necessary to enable You to Utilize the Original Code\n(or portions thereof) and not to any greater extent that may be necessary to\nUtilize further Modifications or combinations.\n\n2.2. Contributor Grant. \u2028Each Contributor hereby grants You a world-wide,\nroyalty-free, non-exclusive license, subject to third party intellectual\nproperty claims:\n\n(a) to use, reproduce, modify, display, perform, sublicense and distribute the\nModifications created by such Contributor (or portions thereof) either on an\nunmodified basis, with other Modifications, as Covered Code or as part of a\nLarger Work; and\u2028 (b) under patents now or hereafter owned or controlled by\nContributor, to Utilize the Contributor Version (or portions thereof), but\nsolely to the extent that any such patent is reasonably necessary to enable\nYou to Utilize the Contributor Version (or portions thereof), and not to any\ngreater extent that may be necessary to Utilize further Modifications or\ncombinations.\n\n3. Distribution Obligations. \n\n3.1. Application of License. The Modifications which You create or to which\nYou contribute are governed by the terms of this License, including without\nlimitation Section 2.2. The Source Code version of Covered Code may be\ndistributed only under the terms of this License or a future version of this\nLicense released under Section 6.1, and You must include a copy of this\nLicense with every copy of the Source Code You distribute. You may not offer\nor impose any terms on any Source Code version that alters or restricts the\napplicable version of this License or the recipients&apos; rights hereunder.\nHowever, You may include an additional document offering the additional rights\ndescribed in Section 3.5.\n\n3.2. Availability of Source Code. Any Modification which You create or to\nwhich You contribute must be made available in Source Code form under the\nterms of this License either on the same media as an Executable version or via\nan accepted Electronic Distribution Mechanism to anyone to whom you made an\nExecutable version available; and if made available via Electronic\nDistribution Mechanism, must remain available for at least twelve (12) months\nafter the date it initially became available, or at least six (6) months after\na subsequent version of that particular Modification has been made available\nto such recipients. You are responsible for ensuring that the Source Code\nversion remains available even if the Electronic Distribution Mechanism is\nmaintained by a third party.\n\n3.3. Description of Modifications. You must cause all Covered Code to which\nyou contribute to contain a file documenting the changes You made to create\nthat Covered Code and the date of any change. You must include a prominent\nstatement that the Modification is derived, directly or indirectly, from\nOriginal Code provided by the Initial Developer and including the name of the\nInitial Developer in (a) the Source Code, and (b) in any notice in an\nExecutable version or related documentation in which You describe the origin\nor ownership of the Covered Code.\n\n3.4. Intellectual Property Matters\n\n(a) Third Party Claims. If You have knowledge that a party claims an\nintellectual property right in particular functionality or code (or its\nutilization under this License), you must include a text file with the source\ncode distribution titled ``LEGAL&apos;&apos; which describes the claim and the\nparty making the claim in sufficient detail that a recipient will know whom to\ncontact. If you obtain such knowledge after You make Your Modification\navailable as described in Section 3.2, You shall promptly modify the LEGAL\nfile in all copies You make available thereafter and shall take other steps\n(such as notifying appropriate mailing lists or newsgroups) reasonably\ncalculated to inform those who received the Covered Code that new knowledge\nhas been obtained.\n\n(b) Contributor APIs. If Your Modification is an application programming\ninterface and You own or control patents which are reasonably necessary to\nimplement that API, you must also include this information in the LEGAL file.\n\n3.5. Required Notices. You must duplicate the notice in Exhibit A in each file\nof the Source Code, and this License in any documentation for the Source Code,\nwhere You describe recipients&apos; rights relating to Covered Code. If You\ncreated one or more Modification(s), You may add your name as a Contributor to\nthe notice described in Exhibit A. If it is not possible to put such notice in\na particular Source Code file due to its structure, then you must include such\nnotice in a location (such as a relevant directory file) where a user would be\nlikely to look for such a notice. You may choose to offer, and to charge a fee\nfor, warranty, support, indemnity or liability obligations to one or more\nrecipients of Covered Code. However, You may do so only on Your own behalf,\nand not on behalf of the Initial Developer or any Contributor. You must make\nit absolutely clear than any such warranty, support, indemnity or liability\nobligation is offered by You alone, and You hereby agree to indemnify the\nInitial Developer and every Contributor for any liability incurred by the\nInitial Developer or such Contributor as a result of warranty, support,\nindemnity or liability terms You offer.\n\n3.6. Distribution of Executable Versions. You may distribute Covered Code in\nExecutable form only if the requirements of Section 3.1-3.5 have been met for\nthat Covered Code, and if You include a notice stating that the Source Code\nversion of the Covered Code is available under the terms of this License,\nincluding a description of how and where You have fulfilled the obligations of\nSection 3.2. The notice must be conspicuously included in any notice in an\nExecutable version, related documentation or collateral in which You describe\nrecipients&apos; rights relating to the Covered Code. You may distribute the\nExecutable version of Covered Code under a license of Your choice, which may\ncontain terms different from this License, provided that You are in compliance\nwith the terms of this License and that the license for the Executable version\ndoes not attempt to limit or alter the recipient&apos;s rights in the Source\nCode version from the rights set forth in this License. If You distribute the\nExecutable version under a different license You must make it absolutely clear\nthat any terms which differ from this License are offered by You alone, not by\nthe Initial Developer or any Contributor. You hereby agree to indemnify the\nInitial Developer and every Contributor for any liability incurred by the\nInitial Developer or such Contributor as a result of any such terms You offer.\n\n3.7. Larger Works. You may create a Larger Work by combining Covered Code with\nother code not governed by the terms of this License and distribute the Larger\nWork as a single product. In such a case, You must make sure the requirements\nof this License are fulfilled for the Covered Code.\n\n4. Inability to Comply Due to Statute or Regulation.   \nIf it is impossible for You to comply with any of the terms of this License\nwith respect to some or all of the Covered Code due to statute or regulation\nthen You must: (a) comply with the terms of this License to the maximum extent\npossible; and (b) describe the limitations and the code they affect. Such\ndescription must be included in the LEGAL file described in Section 3.4 and\nmust be included with all distributions of the Source Code. Except to the\nextent prohibited by statute or regulation, such description must be\nsufficiently detailed for a recipient of ordinary skill to be able to\nunderstand it.\n\n5. Application of this License.   \nThis License applies to code to which the Initial Developer has attached the\nnotice in Exhibit A, and to related Covered Code.\n\n6. Versions of the License.   \n6.1. New Versions. Netscape Communications Corporation\n(``Netscape&apos;&apos;) may publish revised and/or new versions of the\nLicense from time to time. Each version will be given a distinguishing version\nnumber.\n\n6.2. Effect of New Versions. Once Covered Code has been published under a\nparticular version of the License, You may always continue to use it under the\nterms of that version. You may also choose to use such Covered Code under the\nterms of any subsequent version of the License published by Netscape. No one\nother than Netscape has the right to modify the terms applicable to Covered\nCode created under this License.\n\n6.3. Derivative Works. If you create or use a modified version of this License\n(which you may only do in order to apply it to code which is not already\nCovered Code governed by this License), you must (a) rename Your license so\nthat the phrases ``Mozilla&apos;&apos;, ``MOZILLAPL&apos;&apos;,\n``MOZPL&apos;&apos;, ``Netscape&apos;&apos;, ``NPL&apos;&apos; or any\nconfusingly similar phrase do not appear anywhere in your license and (b)\notherwise make it clear that your version of the license contains terms which\ndiffer from the Mozilla Public License and Netscape Public License. (Filling\nin the name of the Initial Developer, Original Code or Contributor in the\nnotice described in Exhibit A shall not of themselves be deemed to be\nmodifications of this License.)\n\n7. DISCLAIMER OF WARRANTY. \n\nCOVERED CODE IS PROVIDED UNDER THIS LICENSE ON AN ``AS IS&apos;&apos; BASIS,\nWITHOUT WARRANTY OF ANY KIND, EITHER EXPRESSED OR IMPLIED, INCLUDING, WITHOUT\nLIMITATION, WARRANTIES THAT THE COVERED CODE IS FREE OF DEFECTS, MERCHANTABLE,\nFIT FOR A PARTICULAR PURPOSE OR NON-INFRINGING. THE ENTIRE RISK AS TO THE\nQUALITY AND PERFORMANCE OF THE COVERED CODE IS WITH YOU. SHOULD ANY COVERED\nCODE PROVE DEFECTIVE IN ANY RESPECT, YOU (NOT THE INITIAL DEVELOPER OR ANY\nOTHER CONTRIBUTOR) ASSUME THE COST OF ANY NECESSARY SERVICING, REPAIR OR\nCORRECTION. THIS DISCLAIMER OF WARRANTY CONSTITUTES AN ESSENTIAL PART OF THIS\nLICENSE. NO USE OF ANY COVERED CODE IS AUTHORIZED HEREUNDER EXCEPT UNDER THIS\nDISCLAIMER.\n\n8. TERMINATION. \n\nThis License and the rights granted hereunder will terminate automatically if\nYou fail to comply with terms herein and fail to cure such breach within 30\ndays of becoming aware of the breach. All sublicenses to the Covered Code\nwhich are properly granted shall survive any termination of this License.\nProvisions which, by their nature, must remain in effect beyond the\ntermination of this License shall survive.\n\n9. LIMITATION OF LIABILITY. \n\nUNDER NO CIRCUMSTANCES AND UNDER NO LEGAL THEORY, WHETHER TORT (INCLUDING\nNEGLIGENCE), CONTRACT, OR OTHERWISE, SHALL THE INITIAL DEVELOPER, ANY OTHER\nCONTRIBUTOR, OR ANY DISTRIBUTOR OF COVERED CODE, OR ANY SUPPLIER OF ANY OF\nSUCH PARTIES, BE LIABLE TO YOU OR ANY OTHER PERSON FOR ANY INDIRECT, SPECIAL,\nINCIDENTAL, OR CONSEQUENTIAL DAMAGES OF ANY CHARACTER INCLUDING, WITHOUT\nLIMITATION, DAMAGES FOR LOSS OF GOODWILL, WORK STOPPAGE, COMPUTER FAILURE OR\nMALFUNCTION, OR ANY AND ALL OTHER COMMERCIAL DAMAGES OR LOSSES, EVEN IF SUCH\nPARTY SHALL HAVE BEEN INFORMED OF THE POSSIBILITY OF SUCH DAMAGES. THIS\nLIMITATION OF LIABILITY SHALL NOT APPLY TO LIABILITY FOR DEATH OR PERSONAL\nINJURY RESULTING FROM SUCH PARTY&apos;S NEGLIGENCE TO THE EXTENT APPLICABLE\nLAW PROHIBITS SUCH LIMITATION. SOME JURISDICTIONS DO NOT ALLOW THE EXCLUSION\nOR LIMITATION OF INCIDENTAL OR CONSEQUENTIAL DAMAGES, SO THAT EXCLUSION AND\nLIMITATION MAY NOT APPLY TO YOU.\n\n10. U.S. GOVERNMENT END USERS. \n\nThe Covered Code is a ``commercial item,&apos;&apos; as that term is defined\nin 48 C.F.R. 2.101 (Oct. 1995), consisting of ``commercial computer\nsoftware&apos;&apos; and ``commercial computer software\ndocumentation,&apos;&apos; as such terms are used in 48 C.F.R. 12.212 (Sept.\n1995). Consistent with 48 C.F.R. 12.212 and 48 C.F.R. 227.7202-1 through\n227.7202-4 (June 1995), all U.S. Government End Users acquire Covered Code\nwith only those rights set forth herein.\n\n11. MISCELLANEOUS. \n\nThis License represents the complete agreement concerning subject matter\nhereof. If any provision of this License is held to be unenforceable, such\nprovision shall be reformed only to the extent necessary to make it\nenforceable. This License shall be governed by California law provisions\n(except to the extent applicable law, if any, provides otherwise), excluding\nits conflict-of-law provisions. With respect to disputes in which at least one\nparty is a citizen of, or an entity chartered or registered to do business in,\nthe United States of America: (a) unless otherwise agreed in writing, all\ndisputes relating to this License (excepting any dispute relating to\nintellectual property rights) shall be subject to final and binding\narbitration, with the losing party paying all costs of arbitration; (b) any\narbitration relating to this Agreement shall be held in Santa Clara County,\nCalifornia, under the auspices of JAMS/EndDispute; and (c) any litigation\nrelating to this Agreement shall be subject to the jurisdiction of the Federal\nCourts of the Northern District of California, with venue lying in Santa Clara\nCounty, California, with the losing party responsible for costs, including\nwithout limitation, court costs and reasonable attorneys fees and expenses.\nThe application of the United Nations Convention on Contracts for the\nInternational Sale of Goods is expressly excluded. Any law or regulation which\nprovides that the language of a contract shall be construed against the\ndrafter shall not apply to this License.\n\n12. RESPONSIBILITY FOR CLAIMS. \n\nExcept in cases where another Contributor has failed to comply with Section\n3.4, You are responsible for damages arising, directly or indirectly, out of\nYour utilization of rights under this License, based on the number of copies\nof Covered Code you made available, the revenues you received from utilizing\nsuch rights, and other relevant factors. You agree to work with affected\nparties to distribute responsibility on an equitable basis.\n\nAMENDMENTS\n\nAdditional Terms applicable to the Netscape Public License.\n\nI. Effect. \u2028These additional terms described in this Netscape Public License\n-- Amendments shall apply to the Mozilla Communicator client code and to all\nCovered Code under this License.\n\nII. ``Netscape&apos;s Branded Code&apos;&apos; means Covered Code that\nNetscape distributes and/or permits others to distribute under one or more\ntrademark(s) which are controlled by Netscape but which are not licensed for\nuse under this License.\n\nIII. Netscape and logo. \u2028 This License does not grant any rights to use the\ntrademark ``Netscape&apos;&apos;, the ``Netscape N and horizon&apos;&apos;\nlogo or the Netscape lighthouse logo, even if such marks are included in the\nOriginal Code.\n\nIV. Inability to Comply Due to Contractual Obligation. \u2028 Prior to licensing\nthe Original Code under this License, Netscape has licensed third party code\nfor use in Netscape&apos;s Branded Code. To the extent that Netscape is\nlimited contractually from making such third party code available under this\nLicense, Netscape may choose to reintegrate such code into Covered Code\nwithout being required to distribute such code in\n\nSource Code form, even if such code would otherwise be considered\n``Modifications&apos;&apos; under this License.\n\nV. Use of Modifications and Covered Code by Initial Developer.\n\nV.1. In General. The obligations of Section 3 apply to Netscape, except to the\nextent specified in this Amendment, Section V.2 and V.3.\u2028 V.2. Other Products.\nNetscape may include Covered Code in products other than the Netscape&apos;s\nBranded Code which are released by Netscape during the two (2) years following\nthe release date of the Original Code, without such additional products\nbecoming subject to the terms of this License, and may license such additional\nproducts on different terms from those contained in this License.\u2028 V.3.\nAlternative Licensing. Netscape may license the Source Code of Netscape&apos;s\nBranded Code, including Modifications incorporated therein, without such\nadditional products becoming subject to the terms of this License, and may\nlicense such additional products on different terms from those contained in\nthis License.\n\nVI. Arbitration and Litigation. \u2028 Notwithstanding the limitations of Section\n11 above, the provisions regarding arbitration and litigation in Section\n11(a), (b) and (c) of the License shall apply to all disputes relating to this\nLicense.\n\nEXHIBIT A.\n\n\u201cThe contents of this file are subject to the Netscape Public License Version\n1.0 (the \"License\"); you may not use this file except in compliance with the\nLicense. You may obtain a copy of the License at http://www.mozilla.org/NPL/\n\nSoftware distributed under the License is distributed on an \"AS IS\" basis,\nWITHOUT WARRANTY OF ANY KIND, either express or implied. See the License for\nthe specific language governing rights and limitations under the License.\n\nThe Original Code is Mozilla Communicator client code, released March 31,\n1998.\n\nThe Initial Developer of the Original Code is Netscape Communications\nCorporation. Portions created by Netscape are Copyright (C) 1998 Netscape\nCommunications Corporation. All Rights Reserved.\n\nContributor(s): ______________________________________.\u201d\u2028\u2028[NOTE: The text of\nthis Exhibit A may differ slightly from the text of the notices in the Source\nCode files of the Original Code. This is due to time constraints encountered\nin simultaneously finalizing the License and in preparing the Original Code\nfor release. You should use the text of this Exhibit A rather than the text\nfound in the Original Code Source Code for Your Modifications.]\n\n" +
+	"", etag: `"y8VDKkIJRLI="`})
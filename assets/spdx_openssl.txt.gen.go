@@ -0,0 +1,7 @@
+// AUTOMATICALLY GENERATED FILE. DO NOT EDIT.
+
+package assets
+
+var spdx_openssl = txt(asset{Name: "spdx_openssl.txt", Content: "" +
+	"---\ntitle: OpenSSL License\nspdx-id: OpenSSL\nsource: https://spdx.org/licenses/OpenSSL.html\nosi-approved: false\nfsf-libre: false\n---\n\nOpenSSL License\n\nCopyright (c) 1998-2008 The OpenSSL Project. All rights reserved.\n\nRedistribution and use in source and binary forms, with or without\nmodification, are permitted provided that the following conditions are met:\n\n1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer. \n\n2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution. \n\n3. All advertising materials mentioning features or use of this software must display the following acknowledgment: \"This product includes software developed by the OpenSSL Project for use in the OpenSSL Toolkit. (http://www.openssl.org/)\" \n\n4. The names \"OpenSSL Toolkit\" and \"OpenSSL Project\" must not be used to endorse or promote products derived from this software without prior written permission. For written permission, please contact openssl-core@openssl.org. \n\n5. Products derived from this software may not be called \"OpenSSL\" nor may \"OpenSSL\" appear in their names without prior written permission of the OpenSSL Project. \n\n6. Redistributions of any form whatsoever must retain the following acknowledgment: \"This product includes software developed by the OpenSSL Project for use in the OpenSSL Toolkit (http://www.openssl.org/)\" \n\nTHIS SOFTWARE IS PROVIDED BY THE OpenSSL PROJECT ``AS IS&apos;&apos; AND ANY\nEXPRESSED OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED\nWARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE\nDISCLAIMED. IN NO EVENT SHALL THE OpenSSL PROJECT OR ITS CONTRIBUTORS BE\nLIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR\nCONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF\nSUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS\nINTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN\nCONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)\nARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE\nPOSSIBILITY OF SUCH DAMAGE.\n\nThis product includes cryptographic software written by Eric Young\n(eay@cryptsoft.com). This product includes software written by Tim Hudson\n(tjh@cryptsoft.com).\n\n\nOriginal SSLeay License\n\nCopyright (C) 1995-1998 Eric Young (eay@cryptsoft.com) All rights reserved.\n\nThis package is an SSL implementation written by Eric Young\n(eay@cryptsoft.com). The implementation was written so as to conform with\nNetscapes SSL.\n\nThis library is free for commercial and non-commercial use as long as the\nfollowing conditions are aheared to. The following conditions apply to all\ncode found in this distribution, be it the RC4, RSA, lhash, DES, etc., code;\nnot just the SSL code. The SSL documentation included with this distribution\nis covered by the same copyright terms except that the holder is Tim Hudson\n(tjh@cryptsoft.com).\n\nCopyright remains Eric Young&apos;s, and as such any Copyright notices in the\ncode are not to be removed. If this package is used in a product, Eric Young\nshould be given attribution as the author of the parts of the library used.\nThis can be in the form of a textual message at program startup or in\ndocumentation (online or textual) provided with the package.\n\nRedistribution and use in source and binary forms, with or without\nmodification, are permitted provided that the following conditions are met:\n\n1. Redistributions of source code must retain the copyright notice, this list of conditions and the following disclaimer. \n\n2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution. \n\n3. All advertising materials mentioning features or use of this software must display the following acknowledgement:   \n\"This product includes cryptographic software written by Eric Young\n(eay@cryptsoft.com)\"\n\nThe word &apos;cryptographic&apos; can be left out if the rouines from the\nlibrary being used are not cryptographic related :-).\n\n4. If you include any Windows specific code (or a derivative thereof) from the apps directory (application code) you must include an acknowledgement: \"This product includes software written by Tim Hudson (tjh@cryptsoft.com)\" \n\nTHIS SOFTWARE IS PROVIDED BY ERIC YOUNG ``AS IS&apos;&apos; AND ANY EXPRESS OR\nIMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF\nMERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO\nEVENT SHALL THE AUTHOR OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT,\nINCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT\nLIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR\nPROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF\nLIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING\nNEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE,\nEVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.\n\nThe licence and distribution terms for any publically available version or\nderivative of this code cannot be changed. i.e. this code cannot simply be\ncopied and put under another distribution licence [including the GNU Public\nLicence.]\n\n" +
+	"", etag: `"npZQyU0eG5k="`})
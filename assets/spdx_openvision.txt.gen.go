@@ -0,0 +1,7 @@
+// AUTOMATICALLY GENERATED FILE. DO NOT EDIT.
+
+package assets
+
+var spdx_openvision = txt(asset{Name: "spdx_openvision.txt", Content: "" +
+	"---\ntitle: OpenVision License\nspdx-id: OpenVision\nsource: https://spdx.org/licenses/OpenVision.html\nosi-approved: false\nfsf-libre: false\n---\n\nCopyright, OpenVision Technologies, Inc., 1993-1996, All Rights\nReserved\n\nWARNING:  Retrieving the OpenVision Kerberos Administration system\nsource code, as described below, indicates your acceptance of the\nfollowing terms.  If you do not agree to the following terms, do\nnot retrieve the OpenVision Kerberos administration system.\n\nYou may freely use and distribute the Source Code and Object Code\ncompiled from it, with or without modification, but this Source\nCode is provided to you \"AS IS\" EXCLUSIVE OF ANY WARRANTY,\nINCLUDING, WITHOUT LIMITATION, ANY WARRANTIES OF MERCHANTABILITY OR\nFITNESS FOR A PARTICULAR PURPOSE, OR ANY OTHER WARRANTY, WHETHER\nEXPRESS OR IMPLIED. IN NO EVENT WILL OPENVISION HAVE ANY LIABILITY\nFOR ANY LOST PROFITS, LOSS OF DATA OR COSTS OF PROCUREMENT OF\nSUBSTITUTE GOODS OR SERVICES, OR FOR ANY SPECIAL, INDIRECT, OR\nCONSEQUENTIAL DAMAGES ARISING OUT OF THIS AGREEMENT, INCLUDING,\nWITHOUT LIMITATION, THOSE RESULTING FROM THE USE OF THE SOURCE\nCODE, OR THE FAILURE OF THE SOURCE CODE TO PERFORM, OR FOR ANY\nOTHER REASON.\n\nOpenVision retains all copyrights in the donated Source Code.\nOpenVision also retains copyright to derivative works of the Source\nCode, whether created by OpenVision or by a third party. The\nOpenVision copyright notice must be preserved if derivative works\nare made based on the donated Source Code.\n\nOpenVision Technologies, Inc. has donated this Kerberos\nAdministration system to MIT for inclusion in the standard Kerberos\n5 distribution. This donation underscores our commitment to\ncontinuing Kerberos technology development and our gratitude for\nthe valuable work which has been performed by MIT and the Kerberos\ncommunity.\n" +
+	"", etag: `"mEPKKF5T8Rs="`})
@@ -0,0 +1,7 @@
+// AUTOMATICALLY GENERATED FILE. DO NOT EDIT.
+
+package assets
+
+var spdx_osl_1_0 = txt(asset{Name: "spdx_osl_1_0.txt", Content: "" +
+	"---\ntitle: Open Software License 1.0\nspdx-id: OSL-1.0\nsource: https://spdx.org/licenses/OSL-1.0.html\nosi-approved: true\nfsf-libre: false\n---\n\nThe Open Software License v. 1.0\n\nThis Open Software License (the \"License\") applies to any original work of\nauthorship (the \"Original Work\") whose owner (the \"Licensor\") has placed the\nfollowing notice immediately following the copyright notice for the Original\nWork:\n\n\"Licensed under the Open Software License version 1.0\"\n\nLicense Terms\n\n1) Grant of Copyright License. Licensor hereby grants You a world-wide,\nroyalty-free, non-exclusive, perpetual, non-sublicenseable license to do the\nfollowing:\n\na) to reproduce the Original Work in copies;\n\nb) to prepare derivative works (\"Derivative Works\") based upon the Original\nWork;\n\nc) to distribute copies of the Original Work and Derivative Works to the\npublic, with the proviso that copies of Original Work or Derivative Works that\nYou distribute shall be licensed under the Open Software License;\n\nd) to perform the Original Work publicly; and\n\ne) to display the Original Work publicly.\n\n2) Grant of Patent License. Licensor hereby grants You a world-wide, royalty-\nfree, non-exclusive, perpetual, non-sublicenseable license, under patent\nclaims owned or controlled by the Licensor that are embodied in the Original\nWork as furnished by the Licensor (\"Licensed Claims\") to make, use, sell and\noffer for sale the Original Work. Licensor hereby grants You a world-wide,\nroyalty-free, non-exclusive, perpetual, non-sublicenseable license under the\nLicensed Claims to make, use, sell and offer for sale Derivative Works.\n\n3) Grant of Source Code License. The term \"Source Code\" means the preferred\nform of the Original Work for making modifications to it and all available\ndocumentation describing how to access and modify the Original Work. Licensor\nhereby agrees to provide a machine-readable copy of the Source Code of the\nOriginal Work along with each copy of the Original Work that Licensor\ndistributes. Licensor reserves the right to satisfy this obligation by placing\na machine-readable copy of the Source Code in an information repository\nreasonably calculated to permit inexpensive and convenient access by You for\nas long as Licensor continues to distribute the Original Work, and by\npublishing the address of that information repository in a notice immediately\nfollowing the copyright notice that applies to the Original Work.\n\n4) Exclusions From License Grant. Nothing in this License shall be deemed to\ngrant any rights to trademarks, copyrights, patents, trade secrets or any\nother intellectual property of Licensor except as expressly stated herein. No\npatent license is granted to make, use, sell or offer to sell embodiments of\nany patent claims other than the Licensed Claims defined in Section 2. No\nright is granted to the trademarks of Licensor even if such marks are included\nin the Original Work. Nothing in this License shall be interpreted to prohibit\nLicensor from licensing under different terms from this License any Original\nWork that Licensor otherwise would have a right to license.\n\n5) External Deployment. The term \"External Deployment\" means the use or\ndistribution of the Original Work or Derivative Works in any way such that the\nOriginal Work or Derivative Works may be accessed or used by anyone other than\nYou, whether the Original Work or Derivative Works are distributed to those\npersons, made available as an application intended for use over a computer\nnetwork, or used to provide services or otherwise deliver content to anyone\nother than You. As an express condition for the grants of license hereunder,\nYou agree that any External Deployment by You shall be deemed a distribution\nand shall be licensed to all under the terms of this License, as prescribed in\nsection 1(c) herein.\n\n6) Warranty and Disclaimer of Warranty. LICENSOR WARRANTS THAT THE COPYRIGHT\nIN AND TO THE ORIGINAL WORK IS OWNED BY THE LICENSOR OR THAT THE ORIGINAL WORK\nIS DISTRIBUTED BY LICENSOR UNDER A VALID CURRENT LICENSE FROM THE COPYRIGHT\nOWNER. EXCEPT AS EXPRESSLY STATED IN THE IMMEDIATELY PRECEEDING SENTENCE, THE\nORIGINAL WORK IS PROVIDED UNDER THIS LICENSE ON AN \"AS IS\" BASIS, WITHOUT\nWARRANTY, EITHER EXPRESS OR IMPLIED, INCLUDING, WITHOUT LIMITATION, THE\nWARRANTY OF NON-INFRINGEMENT AND WARRANTIES THAT THE ORIGINAL WORK IS\nMERCHANTABLE OR FIT FOR A PARTICULAR PURPOSE. THE ENTIRE RISK AS TO THE\nQUALITY OF THE ORIGINAL WORK IS WITH YOU. THIS DISCLAIMER OF WARRANTY\nCONSTITUTES AN ESSENTIAL PART OF THIS LICENSE. NO LICENSE TO ORIGINAL WORK IS\nGRANTED HEREUNDER EXCEPT UNDER THIS DISCLAIMER.\n\n7) Limitation of Liability. UNDER NO CIRCUMSTANCES AND UNDER NO LEGAL THEORY,\nWHETHER TORT (INCLUDING NEGLIGENCE), CONTRACT, OR OTHERWISE, SHALL THE\nLICENSOR BE LIABLE TO ANY PERSON FOR ANY DIRECT, INDIRECT, SPECIAL,\nINCIDENTAL, OR CONSEQUENTIAL DAMAGES OF ANY CHARACTER ARISING AS A RESULT OF\nTHIS LICENSE OR THE USE OF THE ORIGINAL WORK INCLUDING, WITHOUT LIMITATION,\nDAMAGES FOR LOSS OF GOODWILL, WORK STOPPAGE, COMPUTER FAILURE OR MALFUNCTION,\nOR ANY AND ALL OTHER COMMERCIAL DAMAGES OR LOSSES, EVEN IF SUCH PERSON SHALL\nHAVE BEEN INFORMED OF THE POSSIBILITY OF SUCH DAMAGES. THIS LIMITATION OF\nLIABILITY SHALL NOT APPLY TO LIABILITY FOR DEATH OR PERSONAL INJURY RESULTING\nFROM SUCH PARTY&apos;S NEGLIGENCE TO THE EXTENT APPLICABLE LAW PROHIBITS SUCH\nLIMITATION. SOME JURISDICTIONS DO NOT ALLOW THE EXCLUSION OR LIMITATION OF\nINCIDENTAL OR CONSEQUENTIAL DAMAGES, SO THIS EXCLUSION AND LIMITATION MAY NOT\nAPPLY TO YOU.\n\n8) Acceptance and Termination. Nothing else but this License (or another\nwritten agreement between Licensor and You) grants You permission to create\nDerivative Works based upon the Original Work, and any attempt to do so except\nunder the terms of this License (or another written agreement between Licensor\nand You) is expressly prohibited by U.S. copyright law, the equivalent laws of\nother countries, and by international treaty. Therefore, by exercising any of\nthe rights granted to You in Sections 1 and 2 herein, You indicate Your\nacceptance of this License and all of its terms and conditions. This license\nshall terminate immediately and you may no longer exercise any of the rights\ngranted to You by this License upon Your failure to honor the proviso in\nSection 1(c) herein.\n\n9) Mutual Termination for Patent Action. This License shall terminate\nautomatically and You may no longer exercise any of the rights granted to You\nby this License if You file a lawsuit in any court alleging that any OSI\nCertified open source software that is licensed under any license containing\nthis \"Mutual Termination for Patent Action\" clause infringes any patent claims\nthat are essential to use that software.\n\n10) Jurisdiction, Venue and Governing Law. You agree that any lawsuit arising\nunder or relating to this License shall be maintained in the courts of the\njurisdiction wherein the Licensor resides or in which Licensor conducts its\nprimary business, and under the laws of that jurisdiction excluding its\nconflict-of-law provisions. The application of the United Nations Convention\non Contracts for the International Sale of Goods is expressly excluded. Any\nuse of the Original Work outside the scope of this License or after its\ntermination shall be subject to the requirements and penalties of the U.S.\nCopyright Act, 17 U.S.C. \u00a7 101 et seq., the equivalent laws of other\ncountries, and international treaty. This section shall survive the\ntermination of this License.\n\n11) Attorneys Fees. In any action to enforce the terms of this License or\nseeking damages relating thereto, the prevailing party shall be entitled to\nrecover its costs and expenses, including, without limitation, reasonable\nattorneys&apos; fees and costs incurred in connection with such action,\nincluding any appeal of such action. This section shall survive the\ntermination of this License.\n\n12) Miscellaneous. This License represents the complete agreement concerning\nthe subject matter hereof. If any provision of this License is held to be\nunenforceable, such provision shall be reformed only to the extent necessary\nto make it enforceable.\n\n13) Definition of \"You\" in This License. \"You\" throughout this License,\nwhether in upper or lower case, means an individual or a legal entity\nexercising rights under, and complying with all of the terms of, this License.\nFor legal entities, \"You\" includes any entity that controls, is controlled by,\nor is under common control with you. For purposes of this definition,\n\"control\" means (i) the power, direct or indirect, to cause the direction or\nmanagement of such entity, whether by contract or otherwise, or (ii) ownership\nof fifty percent (50%) or more of the outstanding shares, or (iii) beneficial\nownership of such entity.\n\nThis license is Copyright (C) 2002 Lawrence E. Rosen. All rights reserved.\nPermission is hereby granted to copy and distribute this license without\nmodification. This license may not be modified without the express written\npermission of its copyright owner.\n\n" +
+	"", etag: `"r7FDOqe4ERQ="`})
@@ -0,0 +1,7 @@
+// AUTOMATICALLY GENERATED FILE. DO NOT EDIT.
+
+package assets
+
+var spdx_osl_2_1 = txt(asset{Name: "spdx_osl_2_1.txt", Content: "" +
+	"---\ntitle: Open Software License 2.1\nspdx-id: OSL-2.1\nsource: https://spdx.org/licenses/OSL-2.1.html\nosi-approved: true\nfsf-libre: false\n---\n\nThe Open Software Licensev. 2.1\n\nThis Open Software License (the \"License\") applies to any original work of\nauthorship (the \"Original Work\") whose owner (the \"Licensor\") has placed the\nfollowing notice immediately following the copyright notice for the Original\nWork:\n\nLicensed under the Open Software License version 2.1\n\n1) Grant of Copyright License. Licensor hereby grants You a world-wide,\nroyalty-free, non-exclusive, perpetual, sublicenseable license to do the\nfollowing:\n\na) to reproduce the Original Work in copies;\n\nb) to prepare derivative works (\"Derivative Works\") based upon the Original\nWork;\n\nc) to distribute copies of the Original Work and Derivative Works to the\npublic, with the proviso that copies of Original Work or Derivative Works that\nYou distribute shall be licensed under the Open Software License;\n\nd) to perform the Original Work publicly; and\n\ne) to display the Original Work publicly.\n\n2) Grant of Patent License. Licensor hereby grants You a world-wide, royalty-\nfree, non-exclusive, perpetual, sublicenseable license, under patent claims\nowned or controlled by the Licensor that are embodied in the Original Work as\nfurnished by the Licensor, to make, use, sell and offer for sale the Original\nWork and Derivative Works.\n\n3) Grant of Source Code License. The term \"Source Code\" means the preferred\nform of the Original Work for making modifications to it and all available\ndocumentation describing how to modify the Original Work. Licensor hereby\nagrees to provide a machine-readable copy of the Source Code of the Original\nWork along with each copy of the Original Work that Licensor distributes.\nLicensor reserves the right to satisfy this obligation by placing a machine-\nreadable copy of the Source Code in an information repository reasonably\ncalculated to permit inexpensive and convenient access by You for as long as\nLicensor continues to distribute the Original Work, and by publishing the\naddress of that information repository in a notice immediately following the\ncopyright notice that applies to the Original Work.\n\n4) Exclusions From License Grant. Neither the names of Licensor, nor the names\nof any contributors to the Original Work, nor any of their trademarks or\nservice marks, may be used to endorse or promote products derived from this\nOriginal Work without express prior written permission of the Licensor.\nNothing in this License shall be deemed to grant any rights to trademarks,\ncopyrights, patents, trade secrets or any other intellectual property of\nLicensor except as expressly stated herein. No patent license is granted to\nmake, use, sell or offer to sell embodiments of any patent claims other than\nthe licensed claims defined in Section 2. No right is granted to the\ntrademarks of Licensor even if such marks are included in the Original Work.\nNothing in this License shall be interpreted to prohibit Licensor from\nlicensing under different terms from this License any Original Work that\nLicensor otherwise would have a right to license.\n\n5) External Deployment. The term \"External Deployment\" means the use or\ndistribution of the Original Work or Derivative Works in any way such that the\nOriginal Work or Derivative Works may be used by anyone other than You,\nwhether the Original Work or Derivative Works are distributed to those persons\nor made available as an application intended for use over a computer network.\nAs an express condition for the grants of license hereunder, You agree that\nany External Deployment by You of a Derivative Work shall be deemed a\ndistribution and shall be licensed to all under the terms of this License, as\nprescribed in section 1(c) herein.\n\n6) Attribution Rights. You must retain, in the Source Code of any Derivative\nWorks that You create, all copyright, patent or trademark notices from the\nSource Code of the Original Work, as well as any notices of licensing and any\ndescriptive text identified therein as an \"Attribution Notice.\" You must cause\nthe Source Code for any Derivative Works that You create to carry a prominent\nAttribution Notice reasonably calculated to inform recipients that You have\nmodified the Original Work.\n\n7) Warranty of Provenance and Disclaimer of Warranty. Licensor warrants that\nthe copyright in and to the Original Work and the patent rights granted herein\nby Licensor are owned by the Licensor or are sublicensed to You under the\nterms of this License with the permission of the contributor(s) of those\ncopyrights and patent rights. Except as expressly stated in the immediately\nproceeding sentence, the Original Work is provided under this License on an\n\"AS IS\" BASIS and WITHOUT WARRANTY, either express or implied, including,\nwithout limitation, the warranties of NON-INFRINGEMENT, MERCHANTABILITY or\nFITNESS FOR A PARTICULAR PURPOSE. THE ENTIRE RISK AS TO THE QUALITY OF THE\nORIGINAL WORK IS WITH YOU. This DISCLAIMER OF WARRANTY constitutes an\nessential part of this License. No license to Original Work is granted\nhereunder except under this disclaimer.\n\n8) Limitation of Liability. Under no circumstances and under no legal theory,\nwhether in tort (including negligence), contract, or otherwise, shall the\nLicensor be liable to any person for any direct, indirect, special,\nincidental, or consequential damages of any character arising as a result of\nthis License or the use of the Original Work including, without limitation,\ndamages for loss of goodwill, work stoppage, computer failure or malfunction,\nor any and all other commercial damages or losses. This limitation of\nliability shall not apply to liability for death or personal injury resulting\nfrom Licensor&apos;s negligence to the extent applicable law prohibits such\nlimitation. Some jurisdictions do not allow the exclusion or limitation of\nincidental or consequential damages, so this exclusion and limitation may not\napply to You.\n\n9) Acceptance and Termination. If You distribute copies of the Original Work\nor a Derivative Work, You must make a reasonable effort under the\ncircumstances to obtain the express assent of recipients to the terms of this\nLicense. Nothing else but this License (or another written agreement between\nLicensor and You) grants You permission to create Derivative Works based upon\nthe Original Work or to exercise any of the rights granted in Section 1\nherein, and any attempt to do so except under the terms of this License (or\nanother written agreement between Licensor and You) is expressly prohibited by\nU.S. copyright law, the equivalent laws of other countries, and by\ninternational treaty. Therefore, by exercising any of the rights granted to\nYou in Section 1 herein, You indicate Your acceptance of this License and all\nof its terms and conditions. This License shall terminate immediately and you\nmay no longer exercise any of the rights granted to You by this License upon\nYour failure to honor the proviso in Section 1(c) herein.\n\n10) Termination for Patent Action. This License shall terminate automatically\nand You may no longer exercise any of the rights granted to You by this\nLicense as of the date You commence an action, including a cross-claim or\ncounterclaim, against Licensor or any licensee alleging that the Original Work\ninfringes a patent. This termination provision shall not apply for an action\nalleging patent infringement by combinations of the Original Work with other\nsoftware or hardware.\n\n11) Jurisdiction, Venue and Governing Law. Any action or suit relating to this\nLicense may be brought only in the courts of a jurisdiction wherein the\nLicensor resides or in which Licensor conducts its primary business, and under\nthe laws of that jurisdiction excluding its conflict-of-law provisions. The\napplication of the United Nations Convention on Contracts for the\nInternational Sale of Goods is expressly excluded. Any use of the Original\nWork outside the scope of this License or after its termination shall be\nsubject to the requirements and penalties of the U.S. Copyright Act, 17 U.S.C.\n\ufffd 101 et seq., the equivalent laws of other countries, and international\ntreaty. This section shall survive the termination of this License.\n\n12) Attorneys Fees. In any action to enforce the terms of this License or\nseeking damages relating thereto, the prevailing party shall be entitled to\nrecover its costs and expenses, including, without limitation, reasonable\nattorneys&apos; fees and costs incurred in connection with such action,\nincluding any appeal of such action. This section shall survive the\ntermination of this License.\n\n13) Miscellaneous. This License represents the complete agreement concerning\nthe subject matter hereof. If any provision of this License is held to be\nunenforceable, such provision shall be reformed only to the extent necessary\nto make it enforceable.\n\n14) Definition of \"You\" in This License. \"You\" throughout this License,\nwhether in upper or lower case, means an individual or a legal entity\nexercising rights under, and complying with all of the terms of, this License.\nFor legal entities, \"You\" includes any entity that controls, is controlled by,\nor is under common control with you. For purposes of this definition,\n\"control\" means (i) the power, direct or indirect, to cause the direction or\nmanagement of such entity, whether by contract or otherwise, or (ii) ownership\nof fifty percent (50%) or more of the outstanding shares, or (iii) beneficial\nownership of such entity.\n\n15) Right to Use. You may use the Original Work in all ways not otherwise\nrestricted or conditioned by this License or by law, and Licensor promises not\nto interfere with or be responsible for such uses by You.\n\nThis license is Copyright (C) 2003-2004 Lawrence E. Rosen. All rights\nreserved. Permission is hereby granted to copy and distribute this license\nwithout modification. This license may not be modified without the express\nwritten permission of its copyright owner.\n\n" +
+	"", etag: `"9KldZPHwqLg="`})
@@ -0,0 +1,7 @@
+// AUTOMATICALLY GENERATED FILE. DO NOT EDIT.
+
+package assets
+
+var spdx_qhull = txt(asset{Name: "spdx_qhull.txt", Content: "" +
+	"---\ntitle: Qhull License\nspdx-id: Qhull\nsource: https://spdx.org/licenses/Qhull.html\nosi-approved: false\nfsf-libre: false\n---\n\n                    Qhull, Copyright (c) 1993-2019\n                    \n                            C.B. Barber\n                           Arlington, MA \n                          \n                               and\n\n       The National Science and Technology Research Center for\n        Computation and Visualization of Geometric Structures\n                        (The Geometry Center)\n                       University of Minnesota\n\n                       email: qhull@qhull.org\n\nThis software includes Qhull from C.B. Barber and The Geometry Center.  \nQhull is copyrighted as noted above.  Qhull is free software and may \nbe obtained via http from www.qhull.org.  It may be freely copied, modified, \nand redistributed under the following conditions:\n\n1. All copyright notices must remain intact in all files.\n\n2. A copy of this text file must be distributed along with any copies \n   of Qhull that you redistribute; this includes copies that you have \n   modified, or copies of programs or other software products that \n   include Qhull.\n\n3. If you modify Qhull, you must include a notice giving the\n   name of the person performing the modification, the date of\n   modification, and the reason for such modification.\n\n4. When distributing modified versions of Qhull, or other software \n   products that include Qhull, you must provide notice that the original \n   source code may be obtained as noted above.\n\n5. There is no warranty or other guarantee of fitness for Qhull, it is \n   provided solely \"as is\".  Bug reports or fixes may be sent to \n   qhull_bug@qhull.org; the authors may or may not act on them as \n   they desire.\n" +
+	"", etag: `"jOy9uNq5X44="`})
@@ -0,0 +1,7 @@
+// AUTOMATICALLY GENERATED FILE. DO NOT EDIT.
+
+package assets
+
+var spdx_qpl_1_0 = txt(asset{Name: "spdx_qpl_1_0.txt", Content: "" +
+	"---\ntitle: Q Public License 1.0\nspdx-id: QPL-1.0\nsource: https://spdx.org/licenses/QPL-1.0.html\nosi-approved: true\nfsf-libre: false\n---\n\nTHE Q PUBLIC LICENSE version 1.0\n\nCopyright (C) 1999-2005 Trolltech AS, Norway.\n\nEveryone is permitted to copy and distribute this license document.\n\nThe intent of this license is to establish freedom to share and change the\nsoftware regulated by this license under the open source model.\n\nThis license applies to any software containing a notice placed by the\ncopyright holder saying that it may be distributed under the terms of the Q\nPublic License version 1.0. Such software is herein referred to as the\nSoftware. This license covers modification and distribution of the Software,\nuse of third-party application programs based on the Software, and development\nof free software which uses the Software.\n\nGranted Rights\n\n1. You are granted the non-exclusive rights set forth in this license provided you agree to and comply with any and all conditions in this license. Whole or partial distribution of the Software, or software items that link with the Software, in any form signifies acceptance of this license. \n\n2. You may copy and distribute the Software in unmodified form provided that the entire package, including - but not restricted to - copyright, trademark notices and disclaimers, as released by the initial developer of the Software, is distributed. \n\n3. You may make modifications to the Software and distribute your modifications, in a form that is separate from the Software, such as patches. The following restrictions apply to modifications: \n\na. Modifications must not alter or remove any copyright notices in the\nSoftware.\n\nb. When modifications to the Software are released under this license, a non-\nexclusive royalty-free right is granted to the initial developer of the\nSoftware to distribute your modification in future versions of the Software\nprovided such versions remain available under these terms in addition to any\nother license(s) of the initial developer.\n\n4. You may distribute machine-executable forms of the Software or machine-executable forms of modified versions of the Software, provided that you meet these restrictions: \n\na. You must include this license document in the distribution.\n\nb. You must ensure that all recipients of the machine-executable forms are\nalso able to receive the complete machine-readable source code to the\ndistributed Software, including all modifications, without any charge beyond\nthe costs of data transfer, and place prominent notices in the distribution\nexplaining this.\n\nc. You must ensure that all modifications included in the machine-executable\nforms are available under the terms of this license.\n\n5. You may use the original or modified versions of the Software to compile, link and run application programs legally developed by you or by others. \n\n6. You may develop application programs, reusable components and other software items that link with the original or modified versions of the Software. These items, when distributed, are subject to the following requirements: \n\na. You must ensure that all recipients of machine-executable forms of these\nitems are also able to receive and use the complete machine-readable source\ncode to the items without any charge beyond the costs of data transfer.\n\nb. You must explicitly license all recipients of your items to use and re-\ndistribute original and modified versions of the items in both machine-\nexecutable and source code forms. The recipients must be able to do so without\nany charges whatsoever, and they must be able to re-distribute to anyone they\nchoose.\n\nc. If the items are not available to the general public, and the initial\ndeveloper of the Software requests a copy of the items, then you must supply\none.\n\nLimitations of Liability\n\nIn no event shall the initial developers or copyright holders be liable for\nany damages whatsoever, including - but not restricted to - lost revenue or\nprofits or other direct, indirect, special, incidental or consequential\ndamages, even if they have been advised of the possibility of such damages,\nexcept to the extent invariable law, if any, provides otherwise.\n\nNo Warranty\n\nThe Software and this license document are provided AS IS with NO WARRANTY OF\nANY KIND, INCLUDING THE WARRANTY OF DESIGN, MERCHANTABILITY AND FITNESS FOR A\nPARTICULAR PURPOSE.\n\nChoice of Law\n\nThis license is governed by the Laws of Norway. Disputes shall be settled by\nOslo City Court.\n\n" +
+	"", etag: `"9YE3hSMO1hs="`})
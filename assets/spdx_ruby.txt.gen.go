@@ -0,0 +1,7 @@
+// AUTOMATICALLY GENERATED FILE. DO NOT EDIT.
+
+package assets
+
+var spdx_ruby = txt(asset{Name: "spdx_ruby.txt", Content: "" +
+	"---\ntitle: Ruby License\nspdx-id: Ruby\nsource: https://spdx.org/licenses/Ruby.html\nosi-approved: false\nfsf-libre: false\n---\n\n1. You may make and give away verbatim copies of the source form of the software without restriction, provided that you duplicate all of the original copyright notices and associated disclaimers. \n\n2. You may modify your copy of the software in any way, provided that you do at least ONE of the following: \n\na) place your modifications in the Public Domain or otherwise make them Freely\nAvailable, such as by posting said modifications to Usenet or an equivalent\nmedium, or by allowing the author to include your modifications in the\nsoftware.\n\nb) use the modified software only within your corporation or organization.\n\nc) give non-standard binaries non-standard names, with instructions on where\nto get the original software distribution.\n\nd) make other distribution arrangements with the author.\n\n3. You may distribute the software in object code or binary form, provided that you do at least ONE of the following: \n\na) distribute the binaries and library files of the software, together with\ninstructions (in the manual page or equivalent) on where to get the original\ndistribution.\n\nb) accompany the distribution with the machine-readable source of the\nsoftware.\n\nc) give non-standard binaries non-standard names, with instructions on where\nto get the original software distribution.\n\nd) make other distribution arrangements with the author.\n\n4. You may modify and include the part of the software into any other software (possibly commercial). But some files in the distribution are not written by the author, so that they are not under these terms. \n\nFor the list of those files and their copying conditions, see the file LEGAL.\n\n5. The scripts and library files supplied as input to or produced as output from the software do not automatically fall under the copyright of the software, but belong to whomever generated them, and may be sold commercially, and may be aggregated with this software. \n\n6. THIS SOFTWARE IS PROVIDED \"AS IS\" AND WITHOUT ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, WITHOUT LIMITATION, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE.\n\n" +
+	"", etag: `"zf24+7gYruI="`})
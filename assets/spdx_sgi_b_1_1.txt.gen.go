@@ -0,0 +1,7 @@
+// AUTOMATICALLY GENERATED FILE. DO NOT EDIT.
+
+package assets
+
+var spdx_sgi_b_1_1 = txt(asset{Name: "spdx_sgi_b_1_1.txt", Content: "" +
+	"---\ntitle: SGI Free Software License B v1.1\nspdx-id: SGI-B-1.1\nsource: https://spdx.org/licenses/SGI-B-1.1.html\nosi-approved: false\nfsf-libre: false\n---\n\nSGI FREE SOFTWARE LICENSE B\n(Version 1.1 02/22/2000)\n1. Definitions.\n\n1.1 \"Additional Notice Provisions\" means such additional provisions as appear in\nthe Notice in Original Code under the heading \"Additional Notice Provisions.\"\n\n1.2 \"Covered Code\" means the Original Code or Modifications, or any combination\nthereof.\n\n1.3 \"Hardware\" means any physical device that accepts input, processes input,\nstores the results of processing, and/or provides output.\n\n1.4 \"Larger Work\" means a work that combines Covered Code or portions thereof\nwith code not governed by the terms of this License.\n\n1.5 \"Licensable\" means having the right to grant, to the maximum extent\npossible, whether at the time of the initial grant or subsequently acquired, any\nand all of the rights conveyed herein.\n\n1.6 \"License\" means this document.\n\n1.7 \"Licensed Patents\" means patent claims Licensable by SGI that are infringed\nby the use or sale of Original Code or any Modifications provided by SGI, or any\ncombination thereof.\n\n1.8 \"Modifications\" means any addition to or deletion from the substance or\nstructure of the Original Code or any previous Modifications. When Covered Code\nis released as a series of files, a Modification is:\n\nA. Any addition to the contents of a file containing Original Code and/or\naddition to or deletion from the contents of a file containing previous\nModifications.\n\nB. Any new file that contains any part of the Original Code or previous\nModifications.\n\n1.9 \"Notice\" means any notice in Original Code or Covered Code, as required by\nand in compliance with this License.\n\n1.10 \"Original Code\" means source code of computer software code that is\ndescribed in the source code Notice required by Exhibit A as Original Code, and\nupdates and error corrections specifically thereto.\n\n1.11 \"Recipient\" means an individual or a legal entity exercising rights under,\nand complying with all of the terms of, this License or a future version of this\nLicense issued under Section 8. For legal entities, \"Recipient\" includes any\nentity that controls, is controlled by, or is under common control with\nRecipient. For purposes of this definition, \"control\" of an entity means (a) the\npower, direct or indirect, to direct or manage such entity, or (b) ownership of\nfifty percent (50%) or more of the outstanding shares or beneficial ownership of\nsuch entity.\n\n1.12 \"Recipient Patents\" means patent claims Licensable by a Recipient that are\ninfringed by the use or sale of Original Code or any Modifications provided by\nSGI, or any combination thereof.\n\n1.13 \"SGI\" means Silicon Graphics, Inc.\n\n1.14 \"SGI Patents\" means patent claims Licensable by SGI other than the Licensed\nPatents.\n\n2. License Grant and Restrictions.\n\n2.1 SGI License Grant. Subject to the terms of this License and any third party\nintellectual property claims, for the duration of intellectual property\nprotections inherent in the Original Code, SGI hereby grants Recipient a\nworldwide, royalty-free, non-exclusive license, to do the following: (i) under\ncopyrights Licensable by SGI, to reproduce, distribute, create derivative works\nfrom, and, to the extent applicable, display and perform the Original Code\nand/or any Modifications provided by SGI alone and/or as part of a Larger Work;\nand (ii) under any Licensable Patents, to make, have made, use, sell, offer for\nsale, import and/or otherwise transfer the Original Code and/or any\nModifications provided by SGI. Recipient accepts the terms and conditions of\nthis License by undertaking any of the aforementioned actions. The patent\nlicense shall apply to the Covered Code if, at the time any related Modification\nis added, such addition of the Modification causes such combination to be\ncovered by the Licensed Patents. The patent license in Section 2.1(ii) shall not\napply to any other combinations that include the Modification. No patent license\nis provided under SGI Patents for infringements of SGI Patents by Modifications\nnot provided by SGI or combinations of Original Code and Modifications not\nprovided by SGI.\n\n2.2 Recipient License Grant. Subject to the terms of this License and any third\nparty intellectual property claims, Recipient hereby grants SGI and any other\nRecipients a worldwide, royalty-free, non-exclusive license, under any Recipient\nPatents, to make, have made, use, sell, offer for sale, import and/or otherwise\ntransfer the Original Code and/or any Modifications provided by SGI.\n\n2.3 No License For Hardware Implementations. The licenses granted in Section 2.1\nand 2.2 are not applicable to implementation in Hardware of the algorithms\nembodied in the Original Code or any Modifications provided by SGI .\n\n3. Redistributions.\n\n3.1 Retention of Notice/Copy of License. The Notice set forth in Exhibit A,\nbelow, must be conspicuously retained or included in any and all redistributions\nof Covered Code. For distributions of the Covered Code in source code form, the\nNotice must appear in every file that can include a text comments field; in\nexecutable form, the Notice and a copy of this License must appear in related\ndocumentation or collateral where the Recipient\u2019s rights relating to Covered\nCode are described. Any Additional Notice Provisions which actually appears in\nthe Original Code must also be retained or included in any and all\nredistributions of Covered Code.\n\n3.2 Alternative License. Provided that Recipient is in compliance with the terms\nof this License, Recipient may, so long as without derogation of any of SGI\u2019s\nrights in and to the Original Code, distribute the source code and/or executable\nversion(s) of Covered Code under (1) this License; (2) a license identical to\nthis License but for only such changes as are necessary in order to clarify\nRecipient\u2019s role as licensor of Modifications; and/or (3) a license of\nRecipient\u2019s choosing, containing terms different from this License, provided\nthat the license terms include this Section 3 and Sections 4, 6, 7, 10, 12, and\n13, which terms may not be modified or superseded by any other terms of such\nlicense. If Recipient elects to use any license other than this License,\nRecipient must make it absolutely clear that any of its terms which differ from\nthis License are offered by Recipient alone, and not by SGI. It is emphasized\nthat this License is a limited license, and, regardless of the license form\nemployed by Recipient in accordance with this Section 3.2, Recipient may\nrelicense only such rights, in Original Code and Modifications by SGI, as it has\nactually been granted by SGI in this License.\n\n3.3 Indemnity. Recipient hereby agrees to indemnify SGI for any liability\nincurred by SGI as a result of any such alternative license terms Recipient\noffers.\n\n4. Termination. This License and the rights granted hereunder will terminate\nautomatically if Recipient breaches any term herein and fails to cure such\nbreach within 30 days thereof. Any sublicense to the Covered Code that is\nproperly granted shall survive any termination of this License, absent\ntermination by the terms of such sublicense. Provisions that, by their nature,\nmust remain in effect beyond the termination of this License, shall survive.\n\n5. No Trademark Or Other Rights. This License does not grant any rights to: (i)\nany software apart from the Covered Code, nor shall any other rights or licenses\nnot expressly granted hereunder arise by implication, estoppel or otherwise with\nrespect to the Covered Code; (ii) any trade name, trademark or service mark\nwhatsoever, including without limitation any related right for purposes of\nendorsement or promotion of products derived from the Covered Code, without\nprior written permission of SGI; or (iii) any title to or ownership of the\nOriginal Code, which shall at all times remains with SGI. All rights in the\nOriginal Code not expressly granted under this License are reserved.\n\n6. Compliance with Laws; Non-Infringement. There are various worldwide laws,\nregulations, and executive orders applicable to dispositions of Covered Code,\nincluding without limitation export, re-export, and import control laws,\nregulations, and executive orders, of the U.S. government and other countries,\nand Recipient is reminded it is obliged to obey such laws, regulations, and\nexecutive orders. Recipient may not distribute Covered Code that (i) in any way\ninfringes (directly or contributorily) any intellectual property rights of any\nkind of any other person or entity or (ii) breaches any representation or\nwarranty, express, implied or statutory, to which, under any applicable law, it\nmight be deemed to have been subject.\n\n7. Claims of Infringement. If Recipient learns of any third party claim that any\ndisposition of Covered Code and/or functionality wholly or partially infringes\nthe third party's intellectual property rights, Recipient will promptly notify\nSGI of such claim.\n\n8. Versions of the License. SGI may publish revised and/or new versions of the\nLicense from time to time, each with a distinguishing version number. Once\nCovered Code has been published under a particular version of the License,\nRecipient may, for the duration of the license, continue to use it under the\nterms of that version, or choose to use such Covered Code under the terms of any\nsubsequent version published by SGI. Subject to the provisions of Sections 3 and\n4 of this License, only SGI may modify the terms applicable to Covered Code\ncreated under this License.\n\n9. DISCLAIMER OF WARRANTY. COVERED CODE IS PROVIDED \"AS IS.\" ALL EXPRESS AND\nIMPLIED WARRANTIES AND CONDITIONS ARE DISCLAIMED, INCLUDING, WITHOUT LIMITATION,\nANY IMPLIED WARRANTIES AND CONDITIONS OF MERCHANTABILITY, SATISFACTORY QUALITY,\nFITNESS FOR A PARTICULAR PURPOSE, AND NON-INFRINGEMENT. SGI ASSUMES NO RISK AS\nTO THE QUALITY AND PERFORMANCE OF THE SOFTWARE. SHOULD THE SOFTWARE PROVE\nDEFECTIVE IN ANY RESPECT, SGI ASSUMES NO COST OR LIABILITY FOR SERVICING, REPAIR\nOR CORRECTION. THIS DISCLAIMER OF WARRANTY IS AN ESSENTIAL PART OF THIS LICENSE.\nNO USE OF ANY COVERED CODE IS AUTHORIZED HEREUNDER EXCEPT SUBJECT TO THIS\nDISCLAIMER.\n\n10. LIMITATION OF LIABILITY. UNDER NO CIRCUMSTANCES NOR LEGAL THEORY, WHETHER\nTORT (INCLUDING, WITHOUT LIMITATION, NEGLIGENCE OR STRICT LIABILITY), CONTRACT,\nOR OTHERWISE, SHALL SGI OR ANY SGI LICENSOR BE LIABLE FOR ANY DIRECT, INDIRECT,\nSPECIAL, INCIDENTAL, OR CONSEQUENTIAL DAMAGES OF ANY CHARACTER INCLUDING,\nWITHOUT LIMITATION, DAMAGES FOR LOSS OF GOODWILL, WORK STOPPAGE, LOSS OF DATA,\nCOMPUTER FAILURE OR MALFUNCTION, OR ANY AND ALL OTHER COMMERCIAL DAMAGES OR\nLOSSES, EVEN IF SUCH PARTY SHALL HAVE BEEN INFORMED OF THE POSSIBILITY OF SUCH\nDAMAGES. THIS LIMITATION OF LIABILITY SHALL NOT APPLY TO LIABILITY FOR DEATH OR\nPERSONAL INJURY RESULTING FROM SGI's NEGLIGENCE TO THE EXTENT APPLICABLE LAW\nPROHIBITS SUCH LIMITATION. SOME JURISDICTIONS DO NOT ALLOW THE EXCLUSION OR\nLIMITATION OF INCIDENTAL OR CONSEQUENTIAL DAMAGES, SO THAT EXCLUSION AND\nLIMITATION MAY NOT APPLY TO RECIPIENT.\n\n11. Indemnity. Recipient shall be solely responsible for damages arising,\ndirectly or indirectly, out of its utilization of rights under this License.\nRecipient will defend, indemnify and hold harmless Silicon Graphics, Inc. from\nand against any loss, liability, damages, costs or expenses (including the\npayment of reasonable attorneys fees) arising out of Recipient's use,\nmodification, reproduction and distribution of the Covered Code or out of any\nrepresentation or warranty made by Recipient.\n\n12. U.S. Government End Users. The Covered Code is a \"commercial item\"\nconsisting of \"commercial computer software\" as such terms are defined in title\n48 of the Code of Federal Regulations and all U.S. Government End Users acquire\nonly the rights set forth in this License and are subject to the terms of this\nLicense.\n\n13. Miscellaneous. This License represents the complete agreement concerning the\nits subject matter. If any provision of this License is held to be\nunenforceable, such provision shall be reformed so as to achieve as nearly as\npossible the same legal and economic effect as the original provision and the\nremainder of this License will remain in effect. This License shall be governed\nby and construed in accordance with the laws of the United States and the State\nof California as applied to agreements entered into and to be performed entirely\nwithin California between California residents. Any litigation relating to this\nLicense shall be subject to the exclusive jurisdiction of the Federal Courts of\nthe Northern District of California (or, absent subject matter jurisdiction in\nsuch courts, the courts of the State of California), with venue lying\nexclusively in Santa Clara County, California, with the losing party responsible\nfor costs, including without limitation, court costs and reasonable attorneys\nfees and expenses. The application of the United Nations Convention on Contracts\nfor the International Sale of Goods is expressly excluded. Any law or regulation\nthat provides that the language of a contract shall be construed against the\ndrafter shall not apply to this License.\n" +
+	"", etag: `"uWorkSGToUg="`})
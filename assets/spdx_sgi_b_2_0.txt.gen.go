@@ -0,0 +1,7 @@
+// AUTOMATICALLY GENERATED FILE. DO NOT EDIT.
+
+package assets
+
+var spdx_sgi_b_2_0 = txt(asset{Name: "spdx_sgi_b_2_0.txt", Content: "" +
+	"---\ntitle: SGI Free Software License B v2.0\nspdx-id: SGI-B-2.0\nsource: https://spdx.org/licenses/SGI-B-2.0.html\nosi-approved: false\nfsf-libre: false\n---\n\nSGI FREE SOFTWARE LICENSE B\n(Version 2.0, Sept. 18, 2008)\nCopyright (C) [dates of first publication] Silicon Graphics, Inc. All Rights Reserved.\n\nPermission is hereby granted, free of charge, to any person obtaining a copy of\nthis software and associated documentation files (the \"Software\"), to deal in\nthe Software without restriction, including without limitation the rights to\nuse, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of\nthe Software, and to permit persons to whom the Software is furnished to do so,\nsubject to the following conditions:\n\nThe above copyright notice including the dates of first publication and either\nthis permission notice or a reference to http://oss.sgi.com/projects/FreeB/\nshall be included in all copies or substantial portions of the Software.\n\nTHE SOFTWARE IS PROVIDED \"AS IS\", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR\nIMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS\nFOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL SILICON\nGRAPHICS, INC. BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN\nAN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION\nWITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.\n\nExcept as contained in this notice, the name of Silicon Graphics, Inc. shall not\nbe used in advertising or otherwise to promote the sale, use or other dealings\nin this Software without prior written authorization from Silicon Graphics, Inc.\n" +
+	"", etag: `"4hfA/IeVunI="`})
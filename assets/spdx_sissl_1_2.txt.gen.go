@@ -0,0 +1,7 @@
+// AUTOMATICALLY GENERATED FILE. DO NOT EDIT.
+
+package assets
+
+var spdx_sissl_1_2 = txt(asset{Name: "spdx_sissl_1_2.txt", Content: "" +
+	"---\ntitle: Sun Industry Standards Source License v1.2\nspdx-id: SISSL-1.2\nsource: https://spdx.org/licenses/SISSL-1.2.html\nosi-approved: false\nfsf-libre: false\n---\n\nSUN INDUSTRY STANDARDS SOURCE LICENSE\n\nVersion 1.2\n\n\u20281.0 DEFINITIONS\n\n1.1 Commercial Use means distribution or otherwise making the Original Code\navailable to a third party.\n\n1.2 Contributor Version means the combination of the Original Code, and the\nModifications made by that particular Contributor.\n\n1.3 Electronic Distribution Mechanism means a mechanism generally accepted in\nthe software development community for the electronic transfer of data.\n\n1.4 Executable means Original Code in any form other than Source Code.\n\n1.5 Initial Developer means the individual or entity identified as the Initial\nDeveloper in the Source Code notice required by Exhibit A.\n\n1.6 Larger Work means a work which combines Original Code or portions thereof\nwith code not governed by the terms of this License.\n\n1.7 License means this document.\n\n1.8 Licensable means having the right to grant, to the maximum extent\npossible, whether at the time of the initial grant or subsequently acquired,\nany and all of the rights conveyed herein.\n\n1.9 Modifications means any addition to or deletion from the substance or\nstructure of either the Original Code or any previous Modifications. A\nModification is:\n\nA. Any addition to or deletion from the contents of a file containing Original\nCode or previous Modifications.\n\nB. Any new file that contains any part of the Original Code or previous\nModifications.\n\n1.10 Original Code means Source Code of computer software code which is\ndescribed in the Source Code notice required by Exhibit A as Original Code.\n\n1.11 Patent Claims means any patent claim(s), now owned or hereafter acquired,\nincluding without limitation, method, process, and apparatus claims, in any\npatent Licensable by grantor.\n\n1.12 Source Code means the preferred form of the Original Code for making\nmodifications to it, including all modules it contains, plus any associated\ninterface definition files, or scripts used to control compilation and\ninstallation of an Executable.\n\n1.13 Standards means the standards identified in Exhibit B.\n\n1.14 You (or Your) means an individual or a legal entity exercising rights\nunder, and complying with all of the terms of, this License or a future\nversion of this License issued under Section 6.1. For legal entities, You\nincludes any entity which controls, is controlled by, or is under common\ncontrol with You. For purposes of this definition, control means (a) the\npower, direct or indirect, to cause the direction or management of such\nentity, whether by contract or otherwise, or (b) ownership of more than fifty\npercent (50%) of the outstanding shares or beneficial ownership of such\nentity.\n\n2.0 SOURCE CODE LICENSE\n\n2.1 The Initial Developer Grant The Initial Developer hereby grants You a\nworld-wide, royalty-free, non-exclusive license, subject to third party\nintellectual property claims:\n\n(a)under intellectual property rights (other than patent or trademark)\nLicensable by Initial Developer to use, reproduce, modify, display, perform,\nsublicense and distribute the Original Code (or portions thereof) with or\nwithout Modifications, and/or as part of a Larger Work; and\n\n(b) under Patents Claims infringed by the making, using or selling of Original\nCode, to make, have made, use, practice, sell, and offer for sale, and/or\notherwise dispose of the Original Code (or portions thereof).\n\n(c) the licenses granted in this Section 2.1(a) and (b) are effective on the\ndate Initial Developer first distributes Original Code under the terms of this\nLicense.\n\n(d) Notwithstanding Section 2.1(b) above, no patent license is granted: 1) for\ncode that You delete from the Original Code; 2) separate from the Original\nCode; or 3) for infringements caused by: i) the modification of the Original\nCode or ii) the combination of the Original Code with other software or\ndevices, including but not limited to Modifications.\n\n3.0 DISTRIBUTION OBLIGATIONS\n\n3.1 Application of License. The Source Code version of Original Code may be\ndistributed only under the terms of this License or a future version of this\nLicense released under Section 6.1, and You must include a copy of this\nLicense with every copy of the Source Code You distribute. You may not offer\nor impose any terms on any Source Code version that alters or restricts the\napplicable version of this License or the recipients rights hereunder. Your\nlicense for shipment of the Contributor Version is conditioned upon Your full\ncompliance with this Section. The Modifications which You create must comply\nwith all requirements set out by the Standards body in effect one hundred\ntwenty (120) days before You ship the Contributor Version. In the event that\nthe Modifications do not meet such requirements, You agree to publish either\n(i) any deviation from the Standards protocol resulting from implementation of\nYour Modifications and a reference implementation of Your Modifications or\n(ii) Your Modifications in Source Code form, and to make any such deviation\nand reference implementation or Modifications available to all third parties\nunder the same terms a this license on a royalty free basis within thirty (30)\ndays of Your first customer shipment of Your Modifications. Additionally, in\nthe event that the Modifications you create do not meet the requirements set\nout in this Section, You agree to comply with the Standards requirements set\nout in Exhibit B.\n\n3.2 Required Notices. You must duplicate the notice in Exhibit A in each file\nof the Source Code. If it is not possible to put such notice in a particular\nSource Code file due to its structure, then You must include such notice in a\nlocation (such as a relevant directory) where a user would be likely to look\nfor such a notice. If You created one or more Modification(s) You may add Your\nname as a Contributor to the notice described in Exhibit A. You must also\nduplicate this License in any documentation for the Source Code where You\ndescribe recipients rights or ownership rights relating to Initial Code.\n\nYou may choose to offer, and to charge a fee for, warranty, support, indemnity\nor liability obligations to one or more recipients of Your version of the\nCode. However, You may do so only on Your own behalf, and not on behalf of the\nInitial Developer. You must make it absolutely clear than any such warranty,\nsupport, indemnity or liability obligation is offered by You alone, and You\nhereby agree to indemnify the Initial Developer for any liability incurred by\nthe Initial Developer as a result of warranty, support, indemnity or liability\nterms You offer.\n\n3.3 Distribution of Executable Versions. You may distribute Original Code in\nExecutable and Source form only if the requirements of Sections 3.1 and 3.2\nhave been met for that Original Code, and if You include a notice stating that\nthe Source Code version of the Original Code is available under the terms of\nthis License. The notice must be conspicuously included in any notice in an\nExecutable or Source versions, related documentation or collateral in which\nYou describe recipients rights relating to the Original Code. You may\ndistribute the Executable and Source versions of Your version of the Code or\nownership rights under a license of Your choice, which may contain terms\ndifferent from this License, provided that You are in compliance with the\nterms of this License. If You distribute the Executable and Source versions\nunder a different license You must make it absolutely clear that any terms\nwhich differ from this License are offered by You alone, not by the Initial\nDeveloper. You hereby agree to indemnify the Initial Developer for any\nliability incurred by the Initial Developer as a result of any such terms You\noffer.\n\n3.4 Larger Works. You may create a Larger Work by combining Original Code with\nother code not governed by the terms of this License and distribute the Larger\nWork as a single product. In such a case, You must make sure the requirements\nof this License are fulfilled for the Original Code.\n\n4.0 INABILITY TO COMPLY DUE TO STATUTE OR REGULATION\n\nIf it is impossible for You to comply with any of the terms of this License\nwith respect to some or all of the Original Code due to statute, judicial\norder, or regulation then You must: (a) comply with the terms of this License\nto the maximum extent possible; and (b) describe the limitations and the code\nthey affect. Such description must be included in the LEGAL file described in\nSection 3.2 and must be included with all distributions of the Source Code.\nExcept to the extent prohibited by statute or regulation, such description\nmust be sufficiently detailed for a recipient of ordinary skill to be able to\nunderstand it.\n\n5.0 APPLICATION OF THIS LICENSE\n\nThis License applies to code to which the Initial Developer has attached the\nnotice in Exhibit A and to related Modifications as set out in Section 3.1.\n\n6.0 VERSIONS OF THE LICENSE\n\n6.1 New Versions. Sun may publish revised and/or new versions of the License\nfrom time to time. Each version will be given a distinguishing version number.\n\n6.2 Effect of New Versions. Once Original Code has been published under a\nparticular version of the License, You may always continue to use it under the\nterms of that version. You may also choose to use such Original Code under the\nterms of any subsequent version of the License published by Sun. No one other\nthan Sun has the right to modify the terms applicable to Original Code.\n\n7.0 DISCLAIMER OF WARRANTY\n\nORIGINAL CODE IS PROVIDED UNDER THIS LICENSE ON AN AS IS BASIS, WITHOUT\nWARRANTY OF ANY KIND, EITHER EXPRESSED OR IMPLIED, INCLUDING, WITHOUT\nLIMITATION, WARRANTIES THAT THE ORIGINAL CODE IS FREE OF DEFECTS,\nMERCHANTABLE, FIT FOR A PARTICULAR PURPOSE OR NON-INFRINGING. THE ENTIRE RISK\nAS TO THE QUALITY AND PERFORMANCE OF THE ORIGINAL CODE IS WITH YOU. SHOULD ANY\nORIGINAL CODE PROVE DEFECTIVE IN ANY RESPECT, YOU (NOT THE INITIAL DEVELOPER)\nASSUME THE COST OF ANY NECESSARY SERVICING, REPAIR OR CORRECTION. THIS\nDISCLAIMER OF WARRANTY CONSTITUTES AN ESSENTIAL PART OF THIS LICENSE. NO USE\nOF ANY ORIGINAL CODE IS AUTHORIZED HEREUNDER EXCEPT UNDER THIS DISCLAIMER.\n\n8.0 TERMINATION\n\n8.1 This License and the rights granted hereunder will terminate automatically\nif You fail to comply with terms herein and fail to cure such breach within 30\ndays of becoming aware of the breach. All sublicenses to the Original Code\nwhich are properly granted shall survive any termination of this License.\nProvisions which, by their nature, must remain in effect beyond the\ntermination of this License shall survive. 8.2 In the event of termination\nunder Section 8.1 above, all end user license agreements (excluding\ndistributors and resellers) which have been validly granted by You or any\ndistributor hereunder prior to termination shall survive termination.\n\n\nEXHIBIT A - Sun Industry Standards Source License\n\n\"The contents of this file are subject to the Sun Industry Standards Source\nLicense Version 1.2 (the License); You\n\nmay not use this file except in compliance with the License.\"\n\n\"You may obtain a copy of the License at\ngridengine.sunsource.net/license.html\"\n\n\"Software distributed under the License is distributed on an AS IS basis,\nWITHOUT WARRANTY OF ANY KIND, either express or\n\nimplied. See the License for the specific language governing rights and\nlimitations under the License.\"\n\n\"The Original Code is Grid Engine.\"\n\n\"The Initial Developer of the Original Code is:\n\nSun Microsystems, Inc.\"\n\n\"Portions created by: Sun Microsystems, Inc. are Copyright (C) 2001 Sun\nMicrosystems, Inc.\"\n\n\"All Rights Reserved.\"\n\n\"Contributor(s):__________________________________\"\n\nEXHIBIT B - Standards\n\n1.0 Requirements for project Standards. The requirements for project Standards\nare version-dependent and are defined at: Grid Engine standards.\n\n2.0 Additional requirements. The additional requirements pursuant to Section\n3.1 are defined as:\n\n2.1 Naming Conventions. If any of your Modifications do not meet the\nrequirements of the Standard, then you must change the product name so that\nGrid Engine, gridengine, gridengine.sunsource, and similar naming conventions\nare not used.\n\n2.2 Compliance Claims. If any of your Modifications do not meet the\nrequirements of the Standards you may not claim, directly or indirectly, that\nyour implementation of the Standards is compliant.\n\n" +
+	"", etag: `"h3ghY/5DyLQ="`})
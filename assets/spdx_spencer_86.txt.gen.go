@@ -0,0 +1,7 @@
+// AUTOMATICALLY GENERATED FILE. DO NOT EDIT.
+
+package assets
+
+var spdx_spencer_86 = txt(asset{Name: "spdx_spencer_86.txt", Content: "" +
+	"---\ntitle: Spencer License 86\nspdx-id: Spencer-86\nsource: https://spdx.org/licenses/Spencer-86.html\nosi-approved: false\nfsf-libre: false\n---\n\nWritten by Henry Spencer.  Not derived from licensed software.\n\nPermission is granted to anyone to use this software for any\npurpose on any computer system, and to redistribute it freely,\nsubject to the following restrictions:\n\n1. The author is not responsible for the consequences of use of\n        this software, no matter how awful, even if they arise\n        from defects in it.\n\n2. The origin of this software must not be misrepresented, either\n        by explicit claim or by omission.\n\n3. Altered versions must be plainly marked as such, and must not\n        be misrepresented as being the original software.\n" +
+	"", etag: `"R5fos0u3/po="`})
@@ -0,0 +1,7 @@
+// AUTOMATICALLY GENERATED FILE. DO NOT EDIT.
+
+package assets
+
+var spdx_sunpro = txt(asset{Name: "spdx_sunpro.txt", Content: "" +
+	"---\ntitle: SunPro License\nspdx-id: SunPro\nsource: https://spdx.org/licenses/SunPro.html\nosi-approved: false\nfsf-libre: false\n---\n\nPermission to use, copy, modify, and distribute this\nsoftware is freely granted, provided that this notice\nis preserved.\n" +
+	"", etag: `"HenuFKin/NA="`})
@@ -0,0 +1,7 @@
+// AUTOMATICALLY GENERATED FILE. DO NOT EDIT.
+
+package assets
+
+var spdx_unicode_tou = txt(asset{Name: "spdx_unicode_tou.txt", Content: "" +
+	"---\ntitle: Unicode Terms of Use\nspdx-id: Unicode-TOU\nsource: https://spdx.org/licenses/Unicode-TOU.html\nosi-approved: false\nfsf-libre: false\n---\n\nUnicode Terms of Use\n\nFor the general privacy policy governing access to this site, see the Unicode\nPrivacy Policy. For trademark usage, see the Unicode\u00ae Consortium Name and\nTrademark Usage Policy.\n\nA. Unicode Copyright.\n\n1. Copyright \u00a9 1991-2014 Unicode, Inc. All rights reserved. \n\n2. Certain documents and files on this website contain a legend indicating that \"Modification is permitted.\" Any person is hereby authorized, without fee, to modify such documents and files to create derivative works conforming to the Unicode\u00ae Standard, subject to Terms and Conditions herein. \n\n3. Any person is hereby authorized, without fee, to view, use, reproduce, and distribute all documents and files solely for informational purposes in the creation of products supporting the Unicode Standard, subject to the Terms and Conditions herein. \n\n4. Further specifications of rights and restrictions pertaining to the use of the particular set of data files known as the \"Unicode Character Database\" can be found in Exhibit 1. \n\n5. Each version of the Unicode Standard has further specifications of rights and restrictions of use. For the book editions (Unicode 5.0 and earlier), these are found on the back of the title page. The online code charts carry specific restrictions. All other files, including online documentation of the core specification for Unicode 6.0 and later, are covered under these general Terms of Use. \n\n6. No license is granted to \"mirror\" the Unicode website where a fee is charged for access to the \"mirror\" site. \n\n7. Modification is not permitted with respect to this document. All copies of this document must be verbatim. \n\nB. Restricted Rights Legend. Any technical data or software which is licensed\nto the United States of America, its agencies and/or instrumentalities under\nthis Agreement is commercial technical data or commercial computer software\ndeveloped exclusively at private expense as defined in FAR 2.101, or DFARS\n252.227-7014 (June 1995), as applicable. For technical data, use, duplication,\nor disclosure by the Government is subject to restrictions as set forth in\nDFARS 202.227-7015 Technical Data, Commercial and Items (Nov 1995) and this\nAgreement. For Software, in accordance with FAR 12-212 or DFARS 227-7202, as\napplicable, use, duplication or disclosure by the Government is subject to the\nrestrictions set forth in this Agreement.\n\nC. Warranties and Disclaimers.\n\n1. This publication and/or website may include technical or typographical errors or other inaccuracies . Changes are periodically added to the information herein; these changes will be incorporated in new editions of the publication and/or website. Unicode may make improvements and/or changes in the product(s) and/or program(s) described in this publication and/or website at any time. \n\n2. If this file has been purchased on magnetic or optical media from Unicode, Inc. the sole and exclusive remedy for any claim will be exchange of the defective media within ninety (90) days of original purchase. \n\n3. EXCEPT AS PROVIDED IN SECTION C.2, THIS PUBLICATION AND/OR SOFTWARE IS PROVIDED \"AS IS\" WITHOUT WARRANTY OF ANY KIND EITHER EXPRESS, IMPLIED, OR STATUTORY, INCLUDING, BUT NOT LIMITED TO, ANY WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, OR NON-INFRINGEMENT. UNICODE AND ITS LICENSORS ASSUME NO RESPONSIBILITY FOR ERRORS OR OMISSIONS IN THIS PUBLICATION AND/OR SOFTWARE OR OTHER DOCUMENTS WHICH ARE REFERENCED BY OR LINKED TO THIS PUBLICATION OR THE UNICODE WEBSITE. \n\nD. Waiver of Damages. In no event shall Unicode or its licensors be liable for\nany special, incidental, indirect or consequential damages of any kind, or any\ndamages whatsoever, whether or not Unicode was advised of the possibility of\nthe damage, including, without limitation, those resulting from the following:\nloss of use, data or profits, in connection with the use, modification or\ndistribution of this information or its derivatives.\n\nE. Trademarks & Logos.\n\n1. The Unicode Word Mark and the Unicode Logo are trademarks of Unicode, Inc. \u201cThe Unicode Consortium\u201d and \u201cUnicode, Inc.\u201d are trade names of Unicode, Inc. Use of the information and materials found on this website indicates your acknowledgement of Unicode, Inc.\u2019s exclusive worldwide rights in the Unicode Word Mark, the Unicode Logo, and the Unicode trade names. \n\n2. The Unicode Consortium Name and Trademark Usage Policy (\u201cTrademark Policy\u201d) are incorporated herein by reference and you agree to abide by the provisions of the Trademark Policy, which may be changed from time to time in the sole discretion of Unicode, Inc. \n\n3. All third party trademarks referenced herein are the property of their respective owners. \n\nF. Miscellaneous.\n\n1. Jurisdiction and Venue. This server is operated from a location in the State of California, United States of America. Unicode makes no representation that the materials are appropriate for use in other locations. If you access this server from other locations, you are responsible for compliance with local laws. This Agreement, all use of this site and any claims and damages resulting from use of this site are governed solely by the laws of the State of California without regard to any principles which would apply the laws of a different jurisdiction. The user agrees that any disputes regarding this site shall be resolved solely in the courts located in Santa Clara County, California. The user agrees said courts have personal jurisdiction and agree to waive any right to transfer the dispute to any other forum. \n\n2. Modification by Unicode Unicode shall have the right to modify this Agreement at any time by posting it to this site. The user may not assign any part of this Agreement without Unicode\u2019s prior written consent. \n\n3. Taxes. The user agrees to pay any taxes arising from access to this website or use of the information herein, except for those based on Unicode\u2019s net income. \n\n4. Severability. If any provision of this Agreement is declared invalid or unenforceable, the remaining provisions of this Agreement shall remain in effect. \n\n5. Entire Agreement. This Agreement constitutes the entire agreement between the parties. \n\n" +
+	"", etag: `"JuBZnkFx+eg="`})
@@ -0,0 +1,7 @@
+// AUTOMATICALLY GENERATED FILE. DO NOT EDIT.
+
+package assets
+
+var spdx_vim = txt(asset{Name: "spdx_vim.txt", Content: "" +
+	"---\ntitle: Vim License\nspdx-id: Vim\nsource: https://spdx.org/licenses/Vim.html\nosi-approved: false\nfsf-libre: false\n---\n\nVIM LICENSE\n\nI) There are no restrictions on distributing unmodified copies of Vim except\nthat they must include this license text. You can also distribute unmodified\nparts of Vim , likewise unrestricted except that they must include this license\ntext. You are also allowed to include executables that you made from the unmodified\nVim sources, plus your own usage examples and Vim scripts.\n\nII) It is allowed to distribute a modified (or extended) version of Vim ,\nincluding executables and/or source code, when the following four conditions\nare met:\n\n      1) This license text must be included unmodified.\n\n2) The modified Vim must be distributed in one of the following five ways:\n\na) If you make changes to Vim yourself, you must clearly describe in the distribution\nhow to contact you. When the maintainer asks you (in any way) for a copy of\nthe modified Vim you distributed, you must make your changes, including source\ncode, available to the maintainer without fee. The maintainer reserves the\nright to include your changes in the official version of Vim . What the maintainer\nwill do with your changes and under what license they will be distributed\nis negotiable. If there has been no negotiation then this license, or a later\nversion, also applies to your changes. The current maintainer is Bram Moolenaar\n<Bram@vim.org>. If this changes it will be announced in appropriate places\n(most likely vim.sf.net, www.vim.org and/or comp.editors). When it is completely\nimpossible to contact the maintainer, the obligation to send him your changes\nceases. Once the maintainer has confirmed that he has received your changes\nthey will not have to be sent again.\n\nb) If you have received a modified Vim that was distributed as mentioned under\na) you are allowed to further distribute it unmodified, as mentioned at I).\nIf you make additional changes the text under a) applies to those changes.\n\nc) Provide all the changes, including source code, with every copy of the\nmodified Vim you distribute. This may be done in the form of a context diff.\nYou can choose what license to use for new code you add. The changes and their\nlicense must not restrict others from making their own changes to the official\nversion of Vim .\n\nd) When you have a modified Vim which includes changes as mentioned under\nc), you can distribute it without the source code for the changes if the following\nthree conditions are met:\n\n- The license that applies to the changes permits you to distribute the changes\nto the Vim maintainer without fee or restriction, and permits the Vim maintainer\nto include the changes in the official version of Vim without fee or restriction.\n\n- You keep the changes for at least three years after last distributing the\ncorresponding modified Vim . When the maintainer or someone who you distributed\nthe modified Vim to asks you (in any way) for the changes within this period,\nyou must make them available to him.\n\n- You clearly describe in the distribution how to contact you. This contact\ninformation must remain valid for at least three years after last distributing\nthe corresponding modified Vim , or as long as possible.\n\ne) When the GNU General Public License (GPL) applies to the changes, you can\ndistribute the modified Vim under the GNU GPL version 2 or any later version.\n\n3) A message must be added, at least in the output of the \":version\" command\nand in the intro screen, such that the user of the modified Vim is able to\nsee that it was modified. When distributing as mentioned under 2)e) adding\nthe message is only required for as far as this does not conflict with the\nlicense used for the changes.\n\n4) The contact information as required under 2)a) and 2)d) must not be removed\nor changed, except that the person himself can make corrections.\n\nIII) If you distribute a modified version of Vim , you are encouraged to use\nthe Vim license for your changes and make them available to the maintainer,\nincluding the source code. The preferred way to do this is by e-mail or by\nuploading the files to a server and e-mailing the URL. If the number of changes\nis small (e.g., a modified Makefile) e-mailing a context diff will do. The\ne-mail address to be used is <maintainer@vim.org>\n\nIV) It is not allowed to remove this license from the distribution of the\nVim sources, parts of it or from a modified version. You may use this license\nfor previous Vim releases instead of the license that they came with, at your\noption.\n" +
+	"", etag: `"4mUnSsIgiSE="`})
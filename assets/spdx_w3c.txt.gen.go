@@ -0,0 +1,7 @@
+// AUTOMATICALLY GENERATED FILE. DO NOT EDIT.
+
+package assets
+
+var spdx_w3c = txt(asset{Name: "spdx_w3c.txt", Content: "" +
+	"---\ntitle: W3C Software Notice and License (2002-12-31)\nspdx-id: W3C\nsource: https://spdx.org/licenses/W3C.html\nosi-approved: true\nfsf-libre: false\n---\n\nW3C SOFTWARE NOTICE AND LICENSE\n\nThis work (and included software, documentation such as READMEs, or other\nrelated items) is being provided by the copyright holders under the following\nlicense.\n\nLicense\n\nBy obtaining, using and/or copying this work, you (the licensee) agree that\nyou have read, understood, and will comply with the following terms and\nconditions.\n\nPermission to copy, modify, and distribute this software and its\ndocumentation, with or without modification, for any purpose and without fee\nor royalty is hereby granted, provided that you include the following on ALL\ncopies of the software and documentation or portions thereof, including\nmodifications:\n\nThe full text of this NOTICE in a location viewable to users of the\nredistributed or derivative work.\n\nAny pre-existing intellectual property disclaimers, notices, or terms and\nconditions. If none exist, the W3C Software Short Notice should be included\n(hypertext is preferred, text is permitted) within the body of any\nredistributed or derivative code.\n\nNotice of any changes or modifications to the files, including the date\nchanges were made. (We recommend you provide URIs to the location from which\nthe code is derived.)\n\nDisclaimers\n\nTHIS SOFTWARE AND DOCUMENTATION IS PROVIDED \"AS IS,\" AND COPYRIGHT HOLDERS\nMAKE NO REPRESENTATIONS OR WARRANTIES, EXPRESS OR IMPLIED, INCLUDING BUT NOT\nLIMITED TO, WARRANTIES OF MERCHANTABILITY OR FITNESS FOR ANY PARTICULAR\nPURPOSE OR THAT THE USE OF THE SOFTWARE OR DOCUMENTATION WILL NOT INFRINGE ANY\nTHIRD PARTY PATENTS, COPYRIGHTS, TRADEMARKS OR OTHER RIGHTS.\n\nCOPYRIGHT HOLDERS WILL NOT BE LIABLE FOR ANY DIRECT, INDIRECT, SPECIAL OR\nCONSEQUENTIAL DAMAGES ARISING OUT OF ANY USE OF THE SOFTWARE OR DOCUMENTATION.\n\nThe name and trademarks of copyright holders may NOT be used in advertising or\npublicity pertaining to the software without specific, written prior\npermission. Title to copyright in this software and any associated\ndocumentation will at all times remain with copyright holders.\n\nNotes\n\nThis version: http://www.w3.org/Consortium/Legal/2002/copyright-\nsoftware-20021231\n\nThis formulation of W3C&apos;s notice and license became active on December 31\n2002. This version removes the copyright ownership notice such that this\nlicense can be used with materials other than those owned by the W3C, reflects\nthat ERCIM is now a host of the W3C, includes references to this specific\ndated version of the license, and removes the ambiguous grant of \"use\".\nOtherwise, this version is the same as the previous version and is written so\nas to preserve the Free Software Foundation&apos;s assessment of GPL\ncompatibility and OSI&apos;s certification under the Open Source Definition.\n\n" +
+	"", etag: `"THSLXiue1ls="`})
@@ -0,0 +1,7 @@
+// AUTOMATICALLY GENERATED FILE. DO NOT EDIT.
+
+package assets
+
+var spdx_w3c_19980720 = txt(asset{Name: "spdx_w3c_19980720.txt", Content: "" +
+	"---\ntitle: W3C Software Notice and License (1998-07-20)\nspdx-id: W3C-19980720\nsource: https://spdx.org/licenses/W3C-19980720.html\nosi-approved: false\nfsf-libre: false\n---\n\nW3C\u00ae SOFTWARE NOTICE AND LICENSE\n\nCopyright (c) 1994-2002 World Wide Web Consortium, (Massachusetts Institute of\nTechnology, Institut National de Recherche en Informatique et en Automatique,\nKeio University). All Rights Reserved. http://www.w3.org/Consortium/Legal/\n\nThis W3C work (including software, documents, or other related items) is being\nprovided by the copyright holders under the following license. By obtaining,\nusing and/or copying this work, you (the licensee) agree that you have read,\nunderstood, and will comply with the following terms and conditions:\n\nPermission to use, copy, modify, and distribute this software and its\ndocumentation, with or without modification,\u00a0 for any purpose and without fee\nor royalty is hereby granted, provided that you include the following on ALL\ncopies of the software and documentation or portions thereof, including\nmodifications, that you make:\n\n1. The full text of this NOTICE in a location viewable to users of the redistributed or derivative work. \n\n2. Any pre-existing intellectual property disclaimers, notices, or terms and conditions. If none exist, a short notice of the following form (hypertext is preferred, text is permitted) should be used within the body of any redistributed or derivative code: \"Copyright \u00a9 [$date-of-software] World Wide Web Consortium, (Massachusetts Institute of Technology, Institut National de Recherche en Informatique et en Automatique, Keio University). All Rights Reserved. http://www.w3.org/Consortium/Legal/\" \n\n3. Notice of any changes or modifications to the W3C files, including the date changes were made. (We recommend you provide URIs to the location from which the code is derived.) \n\nTHIS SOFTWARE AND DOCUMENTATION IS PROVIDED \"AS IS,\" AND COPYRIGHT HOLDERS\nMAKE NO REPRESENTATIONS OR WARRANTIES, EXPRESS OR IMPLIED, INCLUDING BUT NOT\nLIMITED TO, WARRANTIES OF MERCHANTABILITY OR FITNESS FOR ANY PARTICULAR\nPURPOSE OR THAT THE USE OF THE SOFTWARE OR DOCUMENTATION WILL NOT INFRINGE ANY\nTHIRD PARTY PATENTS, COPYRIGHTS, TRADEMARKS OR OTHER RIGHTS.\n\nCOPYRIGHT HOLDERS WILL NOT BE LIABLE FOR ANY DIRECT, INDIRECT, SPECIAL OR\nCONSEQUENTIAL DAMAGES ARISING OUT OF ANY USE OF THE SOFTWARE OR DOCUMENTATION.\n\nThe name and trademarks of copyright holders may NOT be used in advertising or\npublicity pertaining to the software without specific, written prior\npermission. Title to copyright in this software and any associated\ndocumentation will at all times remain with copyright holders.\n\n____________________________________\n\nThis formulation of W3C&apos;s notice and license became active on August 14\n1998 so as to improve compatibility with GPL. This version ensures that W3C\nsoftware licensing terms are no more restrictive than GPL and consequently W3C\nsoftware may be distributed in GPL packages. See the older formulation for the\npolicy prior to this date. Please see our Copyright FAQ for common questions\nabout using materials from our site, including specific terms and conditions\nfor packages like libwww, Amaya, and Jigsaw. Other questions about this notice\ncan be directed to site-policy@w3.org.\n\n" +
+	"", etag: `"sKiF9nGZdb4="`})
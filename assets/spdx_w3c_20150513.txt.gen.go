@@ -0,0 +1,7 @@
+// AUTOMATICALLY GENERATED FILE. DO NOT EDIT.
+
+package assets
+
+var spdx_w3c_20150513 = txt(asset{Name: "spdx_w3c_20150513.txt", Content: "" +
+	"---\ntitle: W3C Software Notice and Document License (2015-05-13)\nspdx-id: W3C-20150513\nsource: https://spdx.org/licenses/W3C-20150513.html\nosi-approved: false\nfsf-libre: false\n---\n\nW3C SOFTWARE AND DOCUMENT NOTICE AND LICENSE\n\nThis work is being provided by the copyright holders under the following\nlicense.\n\nLicense\n\nBy obtaining and/or copying this work, you (the licensee) agree that you have\nread, understood, and will comply with the following terms and conditions.\n\nPermission to copy, modify, and distribute this work, with or without\nmodification, for any purpose and without fee or royalty is hereby granted,\nprovided that you include the following on ALL copies of the work or portions\nthereof, including modifications:\n\n  * The full text of this NOTICE in a location viewable to users of the\n    redistributed or derivative work.\n\n  * Any pre-existing intellectual property disclaimers, notices, or terms and\n    conditions. If none exist, the W3C Software and Document Short Notice\n    should be included.\n\n  * Notice of any changes or modifications, through a copyright statement on the\n    new code or document such as \"This software or document includes material\n    copied from or derived from [title and URI of the W3C document]. Copyright\n    (C) [YEAR] W3C (R) (MIT, ERCIM, Keio, Beihang).\"\n\nDisclaimers\n\nTHIS WORK IS PROVIDED \"AS IS,\" AND COPYRIGHT HOLDERS MAKE NO REPRESENTATIONS OR\nWARRANTIES, EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO, WARRANTIES OF\nMERCHANTABILITY OR FITNESS FOR ANY PARTICULAR PURPOSE OR THAT THE USE OF THE\nSOFTWARE OR DOCUMENT WILL NOT INFRINGE ANY THIRD PARTY PATENTS, COPYRIGHTS,\nTRADEMARKS OR OTHER RIGHTS.\n\nCOPYRIGHT HOLDERS WILL NOT BE LIABLE FOR ANY DIRECT, INDIRECT, SPECIAL OR\nCONSEQUENTIAL DAMAGES ARISING OUT OF ANY USE OF THE SOFTWARE OR DOCUMENT.\n\nThe name and trademarks of copyright holders may NOT be used in advertising or\npublicity pertaining to the work without specific, written prior permission.\nTitle to copyright in this work will at all times remain with copyright holders.\n" +
+	"", etag: `"glsUaRA6q2k="`})
@@ -0,0 +1,7 @@
+// AUTOMATICALLY GENERATED FILE. DO NOT EDIT.
+
+package assets
+
+var spdx_zlib = txt(asset{Name: "spdx_zlib.txt", Content: "" +
+	"---\ntitle: zlib License\nspdx-id: Zlib\nsource: https://spdx.org/licenses/Zlib.html\nosi-approved: true\nfsf-libre: true\n---\n\n<<beginOptional>>zlib License<<endOptional>>\n\n<<var;name=\"copyright\";original=\"Copyright (c) <year> <copyright holders>\";match=\".{0,5000}\">>\n\nThis software is provided 'as-is', without any express or implied\nwarranty. In no event will the authors be held liable for any damages\narising from the use of this software.\n\nPermission is granted to anyone to use this software for any purpose,\nincluding commercial applications, and to alter it and redistribute it\nfreely, subject to the following restrictions:\n\n1. The origin of this software must not be misrepresented; you must not\n   claim that you wrote the original software. If you use this software\n   in a product, an acknowledgment in the product documentation would be\n   appreciated but is not required.\n\n2. Altered source versions must be plainly marked as such, and must not be\n   misrepresented as being the original software.\n\n3. This notice may not be removed or altered from any source distribution.\n" +
+	"", etag: `"xdpt3otdoXo="`})
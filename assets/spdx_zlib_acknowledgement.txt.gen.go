@@ -0,0 +1,7 @@
+// AUTOMATICALLY GENERATED FILE. DO NOT EDIT.
+
+package assets
+
+var spdx_zlib_acknowledgement = txt(asset{Name: "spdx_zlib_acknowledgement.txt", Content: "" +
+	"---\ntitle: zlib/libpng License with Acknowledgement\nspdx-id: zlib-acknowledgement\nsource: https://spdx.org/licenses/zlib-acknowledgement.html\nosi-approved: false\nfsf-libre: false\n---\n\nCopyright (c) 2002-2007 Charlie Poole\n\nCopyright (c) 2002-2004 James W. Newkirk, Michael C. Two, Alexei A. Vorontsov\n\nCopyright (c) 2000-2002 Philip A. Craig\n\nThis software is provided &apos;as-is&apos;, without any express or implied\nwarranty. In no event will the authors be held liable for any damages arising\nfrom the use of this software.\n\nPermission is granted to anyone to use this software for any purpose,\nincluding commercial applications, and to alter it and redistribute it freely,\nsubject to the following restrictions:\n\n1. The origin of this software must not be misrepresented; you must not claim that you wrote the original software. If you use this software in a product, an acknowledgment (see the following) in the product documentation is required. \n\nPortions Copyright (c) 2002-2007 Charlie Poole or Copyright (c) 2002-2004\nJames W. Newkirk, Michael C. Two, Alexei A. Vorontsov or Copyright (c)\n2000-2002 Philip A. Craig\n\n2. Altered source versions must be plainly marked as such, and must not be misrepresented as being the original software. \n\n3. This notice may not be removed or altered from any source distribution.\n\n" +
+	"", etag: `"eClH9fMpYw8="`})
@@ -0,0 +1,7 @@
+// AUTOMATICALLY GENERATED FILE. DO NOT EDIT.
+
+package assets
+
+var spdx_zpl_1_1 = txt(asset{Name: "spdx_zpl_1_1.txt", Content: "" +
+	"---\ntitle: Zope Public License 1.1\nspdx-id: ZPL-1.1\nsource: https://spdx.org/licenses/ZPL-1.1.html\nosi-approved: false\nfsf-libre: false\n---\n\nZope Public License (ZPL) Version 1.1\n\nCopyright (c) Zope Corporation. All rights reserved.\n\nThis license has been certified as open source.\n\nRedistribution and use in source and binary forms, with or without\nmodification, are permitted provided that the following conditions are met:\n\n1. Redistributions in source code must retain the above copyright notice, this list of conditions, and the following disclaimer. \n\n2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions, and the following disclaimer in the documentation and/or other materials provided with the distribution. \n\n3. All advertising materials and documentation mentioning features derived from or use of this software must display the following acknowledgement: \n\n\"This product includes software developed by Zope Corporation for use in the Z\nObject Publishing Environment (http://www.zope.com/).\"\n\nIn the event that the product being advertised includes an intact Zope\ndistribution (with copyright and license included) then this clause is waived.\n\n4. Names associated with Zope or Zope Corporation must not be used to endorse or promote products derived from this software without prior written permission from Zope Corporation. \n\n5. Modified redistributions of any form whatsoever must retain the following acknowledgment: \n\n\"This product includes software developed by Zope Corporation for use in the Z\nObject Publishing Environment (http://www.zope.com/).\"\n\nIntact (re-)distributions of any official Zope release do not require an\nexternal acknowledgement.\n\n6. Modifications are encouraged but must be packaged separately as patches to official Zope releases. Distributions that do not clearly separate the patches from the original work must be clearly labeled as unofficial distributions. Modifications which do not carry the name Zope may be packaged in any form, as long as they conform to all of the clauses above. \n\nDisclaimer\n\nTHIS SOFTWARE IS PROVIDED BY ZOPE CORPORATION ``AS IS&apos;&apos; AND ANY\nEXPRESSED OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED\nWARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE\nDISCLAIMED. IN NO EVENT SHALL ZOPE CORPORATION OR ITS CONTRIBUTORS BE LIABLE\nFOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL\nDAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR\nSERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER\nCAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,\nOR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE\nOF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.\n\nThis software consists of contributions made by Zope Corporation and many\nindividuals on behalf of Zope Corporation. Specific attributions are listed in\nthe accompanying credits file.\n\n" +
+	"", etag: `"Vc5ClypI+7c="`})
@@ -0,0 +1,1581 @@
+// Package bom collects the dependencies of Go packages, detects their license
+// files and matches them against well-known license templates.
+package bom
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/pmezard/licenses/assets"
+)
+
+// Template holds pre-constructed license template info
+type Template struct {
+	Title    string
+	Nickname string
+	// SPDXID is the SPDX license identifier, if any.
+	SPDXID string
+	// OSIApproved and FSFLibre report whether the license is approved by
+	// the Open Source Initiative and considered free by the FSF.
+	OSIApproved bool
+	FSFLibre    bool
+	// Required, Permitted and Forbidden list the conditions, permissions
+	// and limitations of the license, like "include-copyright".
+	Required  []string
+	Permitted []string
+	Forbidden []string
+	// Metadata holds the entries of the front matter "metadata" block, like
+	// the policy category of a custom license.
+	Metadata map[string]string
+	Words    map[string]int
+	// Optional holds the words of variable and optional sections, which
+	// are not required in a matching license.
+	Optional map[string]int
+	pattern  *regexp.Regexp
+	sequence []templateWord
+	// text is the lowercased template body.
+	text string
+}
+
+func parseTemplate(content string) (*Template, error) {
+	t := Template{}
+	text := []byte{}
+	state := 0
+	// block is the front matter entry holding indented lines
+	block := ""
+	scanner := bufio.NewScanner(strings.NewReader(content))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if state == 0 {
+			if line == "---" {
+				state = 1
+			}
+		} else if state == 1 {
+			if line == "---" {
+				state = 2
+			} else if line == "" {
+				continue
+			} else if strings.TrimLeft(scanner.Text(), " \t") != scanner.Text() {
+				// indented line of a block
+				item := strings.TrimSpace(strings.TrimPrefix(line, "-"))
+				i := strings.Index(line, ":")
+				switch {
+				case block == "required":
+					t.Required = append(t.Required, item)
+				case block == "permitted":
+					t.Permitted = append(t.Permitted, item)
+				case block == "forbidden":
+					t.Forbidden = append(t.Forbidden, item)
+				case block == "metadata" && i > 0:
+					if t.Metadata == nil {
+						t.Metadata = map[string]string{}
+					}
+					t.Metadata[strings.TrimSpace(line[:i])] = strings.TrimSpace(line[i+1:])
+				}
+			} else {
+				block = strings.TrimSuffix(line, ":")
+				if strings.HasPrefix(line, "title:") {
+					t.Title = strings.TrimSpace(line[len("title:"):])
+				} else if strings.HasPrefix(line, "nickname:") {
+					t.Nickname = strings.TrimSpace(line[len("nickname:"):])
+				} else if strings.HasPrefix(line, "spdx-id:") {
+					t.SPDXID = strings.TrimSpace(line[len("spdx-id:"):])
+				} else if strings.HasPrefix(line, "osi-approved:") {
+					t.OSIApproved = strings.TrimSpace(line[len("osi-approved:"):]) == "true"
+				} else if strings.HasPrefix(line, "fsf-libre:") {
+					t.FSFLibre = strings.TrimSpace(line[len("fsf-libre:"):]) == "true"
+				}
+			}
+		} else if state == 2 {
+			text = append(text, scanner.Bytes()...)
+			text = append(text, []byte("\n")...)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	t.text = strings.ToLower(string(text))
+	nodes, err := parseMarkup(string(text))
+	if err != nil {
+		return nil, fmt.Errorf("invalid template %q: %s", t.Title, err)
+	}
+	return &t, compileMarkup(&t, nodes)
+}
+
+var (
+	templatesOnce    sync.Once
+	builtinTemplates []*Template
+	templatesErr     error
+)
+
+// loadTemplates returns the built-in templates. They are parsed once and
+// must not be modified.
+func loadTemplates() ([]*Template, error) {
+	templatesOnce.Do(func() {
+		for _, a := range assets.Assets {
+			templ, err := parseTemplate(a.Content)
+			if err != nil {
+				templatesErr = err
+				return
+			}
+			builtinTemplates = append(builtinTemplates, templ)
+		}
+	})
+	return builtinTemplates, templatesErr
+}
+
+var (
+	reWords     = regexp.MustCompile(`[\w']+`)
+	reCopyright = regexp.MustCompile(
+		`(?i)\s*Copyright (?:©|\(c\)|\xC2\xA9)?\s*(?:\d{4}|\[year\]).*`)
+)
+
+func cleanLicenseData(data []byte) []byte {
+	data = bytes.ToLower(data)
+	data = reCopyright.ReplaceAll(data, nil)
+	return data
+}
+
+// licenseWords returns the sequence of words of cleaned license data.
+func licenseWords(data []byte) []string {
+	words := []string{}
+	for _, t := range licenseTokens(data) {
+		words = append(words, t.Text)
+	}
+	return words
+}
+
+func makeWordSet(tokens []string) map[string]int {
+	words := map[string]int{}
+	for i, s := range tokens {
+		if _, ok := words[s]; !ok {
+			// Non-matching words are likely in the license header, to mention
+			// copyrights and authors. Try to preserve the initial sequences,
+			// to display them later.
+			words[s] = i
+		}
+	}
+	return words
+}
+
+// Word holds word and word position in a license
+type Word struct {
+	Text string
+	Pos  int
+}
+
+type sortedWords []Word
+
+func (s sortedWords) Len() int {
+	return len(s)
+}
+
+func (s sortedWords) Swap(i, j int) {
+	s[i], s[j] = s[j], s[i]
+}
+
+func (s sortedWords) Less(i, j int) bool {
+	return s[i].Pos < s[j].Pos
+}
+
+// MatchResult represents a matched template and matching metrics
+type MatchResult struct {
+	Template     *Template
+	Score        float64
+	ExtraWords   []string
+	MissingWords []string
+	// Changes lists the differences between the license text and the
+	// template, in order.
+	Changes []Change
+}
+
+func sortAndReturnWords(words []Word) []string {
+	sort.Sort(sortedWords(words))
+	tokens := []string{}
+	for _, w := range words {
+		tokens = append(tokens, w.Text)
+	}
+	return tokens
+}
+
+// matchTemplates returns the best license template matching supplied data,
+// its score between 0 and 1 and the list of words appearing in license but not
+// in the matched template. Words of variable and optional template sections
+// are not counted as extra. When the license contains the whole template
+// text, only words outside of it are. The score ignores word order, the
+// ordered differences with the template are listed as changes.
+//
+// Every template is scored, templateIndex finds the same match faster.
+func matchTemplates(license []byte, templates []*Template) MatchResult {
+	tokens := licenseWords(license)
+	return withChanges(matchWords(tokens, templates), tokens)
+}
+
+// matchWords is matchTemplates working on cleaned license words.
+func matchWords(tokens []string, templates []*Template) MatchResult {
+	bestScore := float64(-1)
+	var bestTemplate *Template
+	bestExtra := []Word{}
+	bestMissing := []Word{}
+	allWords := makeWordSet(tokens)
+	for _, t := range templates {
+		score, extra, missing := scoreTemplate(t, tokens, allWords)
+		if score > bestScore {
+			bestScore = score
+			bestTemplate = t
+			bestMissing = missing
+			bestExtra = extra
+		}
+	}
+	return newMatchResult(bestTemplate, bestScore, bestExtra, bestMissing)
+}
+
+// scoreTemplate returns the score of template t for license words, and the
+// extra and missing words. allWords is the word set of tokens.
+func scoreTemplate(t *Template, tokens []string, allWords map[string]int) (float64, []Word, []Word) {
+	words := allWords
+	extra := []Word{}
+	missing := []Word{}
+	for w, pos := range t.Words {
+		if _, ok := words[w]; !ok {
+			missing = append(missing, Word{
+				Text: w,
+				Pos:  pos,
+			})
+		}
+	}
+	if len(missing) == 0 {
+		if outside, ok := matchMarkup(t, tokens); ok {
+			words = makeWordSet(outside)
+			for w := range t.Words {
+				words[w] = allWords[w]
+			}
+		}
+	}
+	common := 0
+	size := 0
+	for w, pos := range words {
+		_, ok := t.Words[w]
+		if ok {
+			common++
+		} else if _, ok := t.Optional[w]; ok {
+			continue
+		} else {
+			extra = append(extra, Word{
+				Text: w,
+				Pos:  pos,
+			})
+		}
+		size++
+	}
+	score := 2 * float64(common) / (float64(size) + float64(len(t.Words)))
+	return score, extra, missing
+}
+
+// newMatchResult returns the match of license words against template t, or
+// an empty match if t is nil. Changes are left to withChanges, as computing
+// them costs more than scoring.
+func newMatchResult(t *Template, score float64, extra, missing []Word) MatchResult {
+	return MatchResult{
+		Template:     t,
+		Score:        score,
+		ExtraWords:   sortAndReturnWords(extra),
+		MissingWords: sortAndReturnWords(missing),
+	}
+}
+
+// withChanges returns match m of license words tokens, with its changes.
+func withChanges(m MatchResult, tokens []string) MatchResult {
+	if m.Template != nil {
+		m.Changes = diffTemplate(m.Template, tokens)
+	}
+	return m
+}
+
+// Options configures a Scan
+type Options struct {
+	// Packages lists the package expressions to scan, as accepted by go list.
+	Packages []string
+	// Binary is the path of a compiled Go executable. If set, the modules
+	// recorded in its build information are scanned instead of Packages. It
+	// cannot be combined with Recursive, Tests, Why or Platforms.
+	Binary string
+	// Dir is the directory go commands are run from. The current directory is
+	// used if empty.
+	Dir string
+	// GOPATH overrides the GOPATH environment variable if not empty.
+	GOPATH string
+	// Tags lists build tags passed to go commands.
+	Tags []string
+	// Recursive scans every module found below Dir in isolation, instead of
+	// the module or workspace containing Dir. Packages defaults to "./..."
+	// and is expanded in every module.
+	Recursive bool
+	// Tests includes the dependencies of the tests of scanned packages, and
+	// reports the scope of every project.
+	Tests bool
+	// Why reports for every project one of the shortest import chains from a
+	// scanned package to the project packages.
+	Why bool
+	// Explain reports for every license the file it was found in, its best
+	// template, score, the words differing from the template and the changes
+	// with the template text.
+	Explain bool
+	// Platforms lists the platforms dependencies are resolved for. Reported
+	// projects are the union of every platform ones. The host platform is
+	// used if empty.
+	Platforms []Platform
+	// Env holds additional environment variables passed to go commands, in
+	// the form "key=value".
+	Env []string
+	// Include lists patterns selecting the scanned packages, by import path
+	// or module path. Patterns follow path.Match syntax, may end with "/..."
+	// and also match the subpackages of matched paths. All packages are
+	// selected if empty.
+	Include []string
+	// Exclude lists patterns of packages left out of the scan, with the same
+	// syntax as Include. Excluded projects are reported separately.
+	Exclude []string
+	// Overrides replaces the licenses of matching projects, or adds projects
+	// whose license could not be detected.
+	Overrides []ProjectAndLicenses
+	// MinConfidence is the confidence below which a detected license is not
+	// trusted. Projects with such a license are reported as uncertain.
+	MinConfidence float64
+	// Jobs is the number of packages whose license files are read and
+	// matched concurrently. GOMAXPROCS is used if zero.
+	Jobs int
+	// TemplateDir is a directory of additional license templates, in the
+	// .txt files. They replace the built-in templates with the same title or
+	// SPDX identifier.
+	TemplateDir string
+}
+
+// environ returns a copy of the process environment where GOPATH and
+// additional variables are adjusted to supplied options. It returns nil if
+// there is nothing to adjust.
+func (opts *Options) environ() []string {
+	if opts.GOPATH == "" && len(opts.Env) == 0 {
+		return nil
+	}
+	env := os.Environ()
+	if opts.GOPATH != "" {
+		env = append(env, "GOPATH="+opts.GOPATH)
+	}
+	// Later values take precedence over the process environment.
+	return append(env, opts.Env...)
+}
+
+// validate returns an error if supplied options cannot be honored together.
+// The build information of a binary records neither its tests, import chains
+// nor build platform, and Recursive scans modules from sources.
+func (opts *Options) validate() error {
+	if opts.Binary == "" {
+		return nil
+	}
+	ignored := []string{}
+	if opts.Recursive {
+		ignored = append(ignored, "Recursive")
+	}
+	if opts.Tests {
+		ignored = append(ignored, "Tests")
+	}
+	if opts.Why {
+		ignored = append(ignored, "Why")
+	}
+	if len(opts.Platforms) > 0 {
+		ignored = append(ignored, "Platforms")
+	}
+	if len(ignored) > 0 {
+		return fmt.Errorf("Binary cannot be combined with %s", strings.Join(ignored, ", "))
+	}
+	return nil
+}
+
+// goCommand returns a go command invocation configured with supplied options.
+func (opts *Options) goCommand(ctx context.Context, args ...string) *exec.Cmd {
+	cmd := exec.CommandContext(ctx, "go", args...)
+	cmd.Dir = opts.Dir
+	cmd.Env = opts.environ()
+	return cmd
+}
+
+// MissingError reports on missing licenses
+type MissingError struct {
+	Err string
+}
+
+func (err *MissingError) Error() string {
+	return err.Err
+}
+
+// PkgError reports on missing packages
+type PkgError struct {
+	Err string
+}
+
+// PkgModule holds the module information reported by go list for packages
+// built in module mode
+type PkgModule struct {
+	Path      string
+	Version   string
+	Replace   *PkgModule
+	Main      bool
+	Indirect  bool
+	Dir       string
+	GoVersion string
+}
+
+// String returns the module path and version, or the path only for local
+// modules and replacements.
+func (m *PkgModule) String() string {
+	if m.Version == "" {
+		return m.Path
+	}
+	return m.Path + "@" + m.Version
+}
+
+// PkgInfo holds identifying package info
+type PkgInfo struct {
+	Name       string
+	Dir        string
+	Root       string
+	ImportPath string
+	Standard   bool
+	DepOnly    bool
+	ForTest    string
+	Imports    []string
+	Deps       []string
+	Module     *PkgModule
+	Error      *PkgError
+	// Platforms lists the platforms the package is a dependency for, when
+	// scanning several of them.
+	Platforms []string `json:"-"`
+	// Scopes lists the scopes the package is a dependency in, when test
+	// dependencies are included.
+	Scopes []string `json:"-"`
+	// ImportChain is one of the shortest import chains from a scanned package
+	// to the package, when requested.
+	ImportChain []string `json:"-"`
+}
+
+// isMissingPackage returns true if supplied go list package error reports a
+// package which cannot be found or built.
+func isMissingPackage(msg string) bool {
+	for _, s := range []string{
+		"cannot find package",
+		"cannot find module providing package",
+		"no required module provides package",
+		"is not in std",
+		"no buildable Go source files",
+		"no Go files",
+	} {
+		if strings.Contains(msg, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// Dependency scopes reported when test dependencies are included
+const (
+	// ScopeRuntime marks packages built into the scanned packages.
+	ScopeRuntime = "runtime"
+	// ScopeTest marks packages only built into the tests of the scanned
+	// packages.
+	ScopeTest = "test"
+)
+
+// listPackagesAndDeps invokes go list once over supplied package expressions
+// and returns information about the matching packages and all their
+// dependencies, sorted by import path. Standard packages are left out.
+// Package records are decoded as they are streamed by go list, so the amount
+// of packages is not limited by the command line length.
+//
+// When test dependencies are included, test variants of packages are folded
+// into their regular package and every package is marked with its scope.
+func listPackagesAndDeps(ctx context.Context, opts Options) ([]*PkgInfo, error) {
+	args := []string{"list", "-deps", "-e", "-json"}
+	if opts.Tests {
+		args = append(args, "-test")
+	}
+	if len(opts.Tags) > 0 {
+		args = append(args, "-tags", strings.Join(opts.Tags, ","))
+	}
+	args = append(args, opts.Packages...)
+	cmd := opts.goCommand(ctx, args...)
+	stderr := &bytes.Buffer{}
+	cmd.Stderr = stderr
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, err
+	}
+	if err := cmd.Start(); err != nil {
+		return nil, err
+	}
+	infos := []*PkgInfo{}
+	missing := []string{}
+	failed := []string{}
+	seen := map[string]bool{}
+	runtime := map[string]bool{}
+	imports := map[string][]string{}
+	roots := []string{}
+	isRoot := map[string]bool{}
+	decoder := json.NewDecoder(stdout)
+	for {
+		info := &PkgInfo{}
+		err := decoder.Decode(info)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			cmd.Process.Kill()
+			cmd.Wait()
+			return nil, fmt.Errorf("could not decode 'go %s' output: %s",
+				strings.Join(args, " "), err)
+		}
+		if opts.Tests {
+			if info.Name == "main" && strings.HasSuffix(info.ImportPath, ".test") {
+				// Generated test main package
+				continue
+			}
+			if stripped := stripTestVariant(info.ImportPath); stripped != info.ImportPath {
+				info.ImportPath = stripped
+			} else if !info.DepOnly {
+				runtime[info.ImportPath] = true
+				for _, dep := range info.Deps {
+					runtime[dep] = true
+				}
+			}
+		}
+		// External test packages live along the tested package.
+		owner := info.ImportPath
+		if info.ForTest != "" && owner == info.ForTest+"_test" {
+			owner = info.ForTest
+		}
+		for _, imp := range info.Imports {
+			imports[owner] = append(imports[owner], stripTestVariant(imp))
+		}
+		if !info.DepOnly && !isRoot[owner] {
+			isRoot[owner] = true
+			roots = append(roots, owner)
+		}
+		if owner != info.ImportPath {
+			continue
+		}
+		if opts.Tests {
+			if seen[info.ImportPath] {
+				continue
+			}
+			seen[info.ImportPath] = true
+		}
+		if info.Error != nil && !info.DepOnly {
+			// Packages given on the command line must exist, unlike their
+			// dependencies which are reported along the others.
+			if isMissingPackage(info.Error.Err) {
+				missing = append(missing, info.Error.Err)
+			} else {
+				failed = append(failed, info.Error.Err)
+			}
+			continue
+		}
+		if info.Standard {
+			continue
+		}
+		if info.Error != nil && info.Name == "" {
+			info.Name = info.ImportPath
+		}
+		infos = append(infos, info)
+	}
+	err = cmd.Wait()
+	if len(missing) > 0 {
+		return nil, &MissingError{Err: strings.Join(missing, "\n")}
+	}
+	if err != nil || len(failed) > 0 {
+		return nil, fmt.Errorf("'go %s' failed with:\n%s%s",
+			strings.Join(args, " "), strings.Join(failed, "\n"), stderr.String())
+	}
+	if opts.Why {
+		chains := importChains(roots, imports)
+		for _, info := range infos {
+			info.ImportChain = chains[info.ImportPath]
+		}
+	}
+	if opts.Tests {
+		for _, info := range infos {
+			if runtime[info.ImportPath] {
+				info.Scopes = []string{ScopeRuntime}
+			} else {
+				info.Scopes = []string{ScopeTest}
+			}
+		}
+	}
+	sort.Slice(infos, func(i, j int) bool {
+		return infos[i].ImportPath < infos[j].ImportPath
+	})
+	return infos, nil
+}
+
+// stripTestVariant returns the import path of the package a test variant
+// reported by go list -test is built from, like "a/b" for "a/b [a/b.test]".
+func stripTestVariant(path string) string {
+	if i := strings.Index(path, " ["); i >= 0 {
+		return path[:i]
+	}
+	return path
+}
+
+// importChains returns, for every package reachable from supplied root
+// packages in the imports graph, one of the shortest import chains leading
+// from a root package to it, both included. Roots and imports are visited in
+// lexical order so the chains are deterministic.
+func importChains(roots []string, imports map[string][]string) map[string][]string {
+	parents := map[string]string{}
+	visited := map[string]bool{}
+	queue := append([]string{}, roots...)
+	sort.Strings(queue)
+	for _, r := range queue {
+		visited[r] = true
+	}
+	for len(queue) > 0 {
+		pkg := queue[0]
+		queue = queue[1:]
+		deps := append([]string{}, imports[pkg]...)
+		sort.Strings(deps)
+		for _, dep := range deps {
+			if !visited[dep] {
+				visited[dep] = true
+				parents[dep] = pkg
+				queue = append(queue, dep)
+			}
+		}
+	}
+	chains := map[string][]string{}
+	for pkg := range visited {
+		chain := []string{pkg}
+		for p, ok := parents[pkg]; ok; p, ok = parents[p] {
+			chain = append(chain, p)
+		}
+		for i, j := 0, len(chain)-1; i < j; i, j = i+1, j-1 {
+			chain[i], chain[j] = chain[j], chain[i]
+		}
+		chains[pkg] = chain
+	}
+	return chains
+}
+
+var (
+	reLicense = regexp.MustCompile(`(?i)^(?:` +
+		`((?:un)?licen[sc]e(?:[-_][a-z0-9]+)?(?:\.[^.]+)?)|` +
+		`(copy(?:ing|right)(?:\.[^.]+)?)|` +
+		`)$`)
+)
+
+// scoreLicenseName returns a factor between 0 and 1 weighting how likely
+// supplied filename is a license file.
+func scoreLicenseName(name string) int8 {
+	if strings.HasSuffix(name, ".go") {
+		return 0
+	}
+	m := reLicense.FindStringSubmatch(name)
+	switch {
+	case m == nil:
+		break
+	case m[1] != "" || m[2] != "":
+		return 1
+	}
+	return 0
+}
+
+// licenseRoot returns the topmost directory to look for license files of
+// supplied package: the module root in module mode, the first import path
+// component below $GOPATH/src otherwise.
+func licenseRoot(info *PkgInfo) string {
+	root := info.Dir
+	if m := info.Module; m != nil {
+		if m.Dir != "" {
+			root = m.Dir
+		} else {
+			// Vendored modules have no directory of their own, derive it from
+			// the package one.
+			rel := filepath.FromSlash(strings.TrimPrefix(info.ImportPath, m.Path))
+			if strings.HasSuffix(info.Dir, rel) {
+				root = info.Dir[:len(info.Dir)-len(rel)]
+			}
+		}
+	} else if info.Root != "" {
+		first := strings.SplitN(info.ImportPath, "/", 2)[0]
+		root = filepath.Join(info.Root, "src", first)
+	}
+	rel, err := filepath.Rel(root, info.Dir)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return info.Dir
+	}
+	return root
+}
+
+// findLicenses looks for license files in package directory, and up to parent
+// directories until a file is found or the module root, or $GOPATH/src, is
+// reached. It returns a slice of paths all viable files, or a slice containing
+// one empty string if none were found, and the paths of the NOTICE files of
+// all directories up to the project root, from the package one. Directory
+// listings are cached in dirs.
+func findLicenses(info *PkgInfo, dirs *onceCache) ([]string, []string, error) {
+	root, err := projectRoot(info, dirs)
+	if err != nil {
+		return []string{""}, nil, err
+	}
+	licenses := []string{}
+	notices := []string{}
+	inProject := true
+	for _, dir := range licenseDirs(info) {
+		if !inProject && len(licenses) > 0 {
+			break
+		}
+		fis, err := readDir(dirs, dir)
+		if err != nil {
+			return []string{""}, nil, err
+		}
+		allViableNames := make([]string, 0)
+		for _, fi := range fis {
+			if !fi.Mode().IsRegular() {
+				continue
+			}
+			if reNotice.MatchString(fi.Name()) {
+				if inProject {
+					notices = append(notices, filepath.Join(dir, fi.Name()))
+				}
+				continue
+			}
+			score := scoreLicenseName(fi.Name())
+			if score == 1 {
+				allViableNames = append(allViableNames, filepath.Join(dir, fi.Name()))
+			}
+		}
+		if len(licenses) == 0 {
+			licenses = allViableNames
+		}
+		if dir == root {
+			inProject = false
+		}
+	}
+	if len(licenses) == 0 {
+		return []string{""}, notices, nil
+	}
+	return licenses, notices, nil
+}
+
+// readDir returns the entries of directory dir, read once per dirs cache.
+func readDir(dirs *onceCache, dir string) ([]os.FileInfo, error) {
+	v, err := dirs.get(dir, func() (interface{}, error) {
+		return ioutil.ReadDir(dir)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return v.([]os.FileInfo), nil
+}
+
+var (
+	// vcsDirs holds the names of the metadata directories found at the root
+	// of repositories.
+	vcsDirs = map[string]bool{".git": true, ".hg": true, ".svn": true, ".bzr": true}
+	// repoDepths maps code hosting sites to the number of import path
+	// components of their repository roots.
+	repoDepths = map[string]int{
+		"bitbucket.org": 3,
+		"github.com":    3,
+		"gitlab.com":    3,
+		"golang.org":    3,
+	}
+)
+
+// projectRoot returns the root directory of the project of supplied
+// package. It is the license root in module mode. In GOPATH mode, it is the
+// closest directory holding version control metadata, or else the
+// repository root of packages of well-known hosting sites, or else the
+// license root.
+func projectRoot(info *PkgInfo, dirs *onceCache) (string, error) {
+	root := licenseRoot(info)
+	if info.Module != nil || info.Root == "" {
+		return root, nil
+	}
+	for _, dir := range licenseDirs(info) {
+		fis, err := readDir(dirs, dir)
+		if err != nil {
+			return "", err
+		}
+		for _, fi := range fis {
+			if fi.IsDir() && vcsDirs[fi.Name()] {
+				return dir, nil
+			}
+		}
+	}
+	parts := strings.Split(info.ImportPath, "/")
+	if n := repoDepths[parts[0]]; n > 0 && len(parts) >= n {
+		return filepath.Join(info.Root, "src", filepath.Join(parts[:n]...)), nil
+	}
+	return root, nil
+}
+
+// licenseDirs returns the directories to look for license files of supplied
+// package, from the package one up to its license root.
+func licenseDirs(info *PkgInfo) []string {
+	root := licenseRoot(info)
+	dirs := []string{}
+	for dir := info.Dir; ; dir = filepath.Dir(dir) {
+		dirs = append(dirs, dir)
+		if dir == root || filepath.Dir(dir) == dir {
+			break
+		}
+	}
+	return dirs
+}
+
+// GoPackage represents a top-level package, ex. colors/blue
+type GoPackage struct {
+	PackageName string
+	Module      *PkgModule
+	Platforms   []string
+	Scopes      []string
+	ImportChain []string
+	RawLicenses []*RawLicense
+	// Choice is true if the licenses are alternatives.
+	Choice bool
+	// Notices holds the texts of the NOTICE files of the package.
+	Notices []string
+	Err     string
+}
+
+// merge folds the build attributes and notices of another package sharing the
+// same license into gp.
+func (gp *GoPackage) merge(other GoPackage) {
+	gp.Platforms = mergeStrings(gp.Platforms, other.Platforms)
+	gp.Scopes = mergeStrings(gp.Scopes, other.Scopes)
+	gp.ImportChain = shortestChain(gp.ImportChain, other.ImportChain)
+	gp.Notices = mergeNotices(gp.Notices, other.Notices)
+}
+
+// shortestChain returns the shortest non-empty import chain of a and b, a if
+// they have the same length.
+func shortestChain(a, b []string) []string {
+	if len(a) == 0 || (len(b) > 0 && len(b) < len(a)) {
+		return b
+	}
+	return a
+}
+
+// RawLicense holds template-matched file data
+type RawLicense struct {
+	Path         string
+	Score        float64
+	Template     *Template
+	ExtraWords   []string
+	MissingWords []string
+	Changes      []Change
+	// Segments lists the license texts of files holding more than one, in
+	// order. The other fields describe the whole file.
+	Segments []Segment
+	// Copyrights lists the copyright notices of the file.
+	Copyrights []Copyright
+	// Source is SourceReadme if the license was detected in the license
+	// section of a README file, instead of a license file.
+	Source string
+}
+
+// newGoPackage returns a package named after supplied package information,
+// without license.
+func newGoPackage(name string, info *PkgInfo) GoPackage {
+	return GoPackage{
+		PackageName: name,
+		Module:      info.Module,
+		Platforms:   info.Platforms,
+		Scopes:      info.Scopes,
+		ImportChain: info.ImportChain,
+	}
+}
+
+// listPackagesWithLicenses lists the packages described by supplied options
+// and matches their license files against templates. Packages left out by
+// include and exclude patterns are returned separately, without license.
+func listPackagesWithLicenses(ctx context.Context, opts Options, templates []*Template) ([]GoPackage, []GoPackage, error) {
+	index := newTemplateIndex(templates)
+	var infos []*PkgInfo
+	var err error
+	if opts.Binary != "" {
+		infos, err = listBinaryModules(ctx, opts)
+		if err != nil {
+			return nil, nil, fmt.Errorf("could not list %s modules: %s", opts.Binary, err)
+		}
+	} else {
+		opts.Packages, err = expandWorkspacePatterns(ctx, opts)
+		if err != nil {
+			return nil, nil, err
+		}
+		infos, err = listPlatformPackages(ctx, opts)
+		if err != nil {
+			if _, ok := err.(*MissingError); ok {
+				return nil, nil, err
+			}
+			return nil, nil, fmt.Errorf("could not list %s dependencies: %s",
+				strings.Join(opts.Packages, " "), err)
+		}
+	}
+	infos, excludedInfos, err := filterPackages(infos, opts.Include, opts.Exclude)
+	if err != nil {
+		return nil, nil, err
+	}
+	excluded := []GoPackage{}
+	for _, info := range excludedInfos {
+		excluded = append(excluded, newGoPackage(info.ImportPath, info))
+	}
+
+	// Cache matched licenses by path. Useful for package with a lot of
+	// subpackages like bleve.
+	matched := &onceCache{}
+	choices := &onceCache{}
+	readmes := &onceCache{}
+	notices := &onceCache{}
+	dirs := &onceCache{}
+
+	gPackages := make([]GoPackage, len(infos))
+	err = forEach(len(infos), opts.Jobs, func(i int) error {
+		info := infos[i]
+		if info.Error != nil {
+			gPackage := newGoPackage(info.Name, info)
+			gPackage.Err = info.Error.Err
+			gPackage.RawLicenses = []*RawLicense{{Path: ""}}
+			gPackages[i] = gPackage
+			return nil
+		}
+		paths, noticePaths, err := findLicenses(info, dirs)
+		if err != nil {
+			return err
+		}
+		gPackage := newGoPackage(info.ImportPath, info)
+		for _, path := range noticePaths {
+			v, err := notices.get(path, func() (interface{}, error) {
+				return readNotice(path)
+			})
+			if err != nil {
+				return err
+			}
+			gPackage.Notices = mergeNotices(gPackage.Notices, []string{v.(string)})
+		}
+		if paths[0] == "" {
+			// Fall back to the license section of a README file
+			path, section, err := findReadmeLicense(info, dirs)
+			if err != nil {
+				return err
+			}
+			if path != "" {
+				v, _ := readmes.get(path, func() (interface{}, error) {
+					return matchReadmeLicense(path, section, index), nil
+				})
+				rl := v.(RawLicense)
+				gPackage.RawLicenses = []*RawLicense{&rl}
+				gPackage.Choice = hasLicenseChoice(section)
+				gPackages[i] = gPackage
+				return nil
+			}
+		}
+		rawLicenseInfos := []*RawLicense{}
+		choice, err := choices.get(paths[0], func() (interface{}, error) {
+			return findLicenseChoice(paths)
+		})
+		if err != nil {
+			return err
+		}
+		gPackage.Choice = choice.(bool)
+		for _, path := range paths {
+			rl := RawLicense{Path: path}
+			if path != "" {
+				v, err := matched.get(path, func() (interface{}, error) {
+					return matchLicenseFile(path, index)
+				})
+				if err != nil {
+					return err
+				}
+				rl = v.(RawLicense)
+			}
+			rawLicenseInfos = append(rawLicenseInfos, &rl)
+		}
+		gPackage.RawLicenses = rawLicenseInfos
+		gPackages[i] = gPackage
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return gPackages, excluded, nil
+}
+
+// matchLicenseFile matches the license file at path against indexed
+// templates.
+func matchLicenseFile(path string, index *templateIndex) (RawLicense, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return RawLicense{}, err
+	}
+	tokens := licenseWords(data)
+	m := withChanges(index.match(tokens), tokens)
+	return RawLicense{
+		Path:         path,
+		Score:        m.Score,
+		Template:     m.Template,
+		ExtraWords:   m.ExtraWords,
+		MissingWords: m.MissingWords,
+		Changes:      m.Changes,
+		Segments:     matchSegments(data, index),
+		Copyrights:   findCopyrights(data, m.Template),
+	}, nil
+}
+
+// matchReadmeLicense matches the license section of the README file at path
+// against indexed templates. Sections scoring below readmeMinScore only
+// match the license they name, if any.
+func matchReadmeLicense(path string, section []byte, index *templateIndex) RawLicense {
+	tokens := licenseWords(section)
+	m := index.match(tokens)
+	if m.Score >= readmeMinScore {
+		m = withChanges(m, tokens)
+	} else {
+		m = MatchResult{}
+		if t := namedTemplate(section, index.templates); t != nil {
+			m = MatchResult{Template: t, Score: readmeMinScore}
+		}
+	}
+	return RawLicense{
+		Path:         path,
+		Score:        m.Score,
+		Template:     m.Template,
+		ExtraWords:   m.ExtraWords,
+		MissingWords: m.MissingWords,
+		Changes:      m.Changes,
+		Copyrights:   findCopyrights(section, m.Template),
+		Source:       SourceReadme,
+	}
+}
+
+// longestCommonPrefix returns the longest common prefix over import path
+// components of supplied licenses.
+func longestCommonPrefix(gPackages []GoPackage) string {
+	type Node struct {
+		Name     string
+		Children map[string]*Node
+		Shared   int
+	}
+	// Build a prefix tree. Not super efficient, but easy to do.
+	root := &Node{
+		Children: map[string]*Node{},
+		Shared:   len(gPackages),
+	}
+	for _, l := range gPackages {
+		n := root
+		for _, part := range strings.Split(l.PackageName, "/") {
+			c := n.Children[part]
+			if c == nil {
+				c = &Node{
+					Name:     part,
+					Children: map[string]*Node{},
+				}
+				n.Children[part] = c
+			}
+			c.Shared++
+			n = c
+		}
+	}
+	n := root
+	prefix := []string{}
+	for {
+		if len(n.Children) != 1 {
+			break
+		}
+		for _, c := range n.Children {
+			if c.Shared == len(gPackages) {
+				// Handle case where there are subpackages:
+				// prometheus/procfs
+				// prometheus/procfs/xfs
+				prefix = append(prefix, c.Name)
+			}
+			n = c
+			break
+		}
+	}
+	return strings.Join(prefix, "/")
+}
+
+// groupPackagesByLicense returns the input packages after grouping them by license
+// path and find their longest import path common prefix. Entries with empty
+// paths are left unchanged.
+func groupPackagesByLicense(gPackages []GoPackage) ([]GoPackage, error) {
+	paths := map[string][]GoPackage{}
+	for _, gp := range gPackages {
+		for _, rl := range gp.RawLicenses {
+			if rl.Path == "" {
+				continue
+			}
+			paths[rl.Path] = append(paths[rl.Path], gp)
+		}
+	}
+	for k, v := range paths {
+		if len(v) <= 1 {
+			continue
+		}
+		prefix := longestCommonPrefix(v)
+		if prefix == "" {
+			return nil, fmt.Errorf(
+				"packages share the same license but not common prefix: %v", v)
+		}
+		gp := v[0]
+		gp.PackageName = prefix
+		for _, other := range v[1:] {
+			gp.merge(other)
+		}
+		paths[k] = []GoPackage{gp}
+	}
+	kept := []GoPackage{}
+	// Ensures only one package with multiple licenses is appended to the list of
+	// kept packages
+	seen := make(map[string]bool)
+	for _, gp := range gPackages {
+		if len(gp.RawLicenses) == 0 {
+			kept = append(kept, gp)
+			continue
+		}
+		for _, rl := range gp.RawLicenses {
+			if rl.Path == "" {
+				kept = append(kept, gp)
+				continue
+			}
+			if v, ok := paths[rl.Path]; ok {
+				if _, ok := seen[v[0].PackageName]; !ok {
+					kept = append(kept, v[0])
+					delete(paths, rl.Path)
+					seen[v[0].PackageName] = true
+				}
+			}
+		}
+	}
+	return kept, nil
+}
+
+// ProjectAndLicenses is a report record holding the licenses detected for a
+// project, or why they could not be.
+type ProjectAndLicenses struct {
+	Project   string    `json:"project"`
+	Main      bool      `json:"main,omitempty"`
+	Version   string    `json:"version,omitempty"`
+	Replace   string    `json:"replace,omitempty"`
+	Platforms []string  `json:"platforms,omitempty"`
+	Scope     []string  `json:"scope,omitempty"`
+	Why       []string  `json:"why,omitempty"`
+	Licenses  []License `json:"licenses,omitempty"`
+	// Expression is the SPDX license expression of the project licenses.
+	Expression string      `json:"expression,omitempty"`
+	Copyrights []Copyright `json:"copyrights,omitempty"`
+	// Notices holds the texts of the project NOTICE files.
+	Notices []string `json:"notices,omitempty"`
+	Error   string   `json:"error,omitempty"`
+}
+
+// newProjectAndLicenses returns a record for supplied package, filled with its
+// module version, replacement, platforms, scopes and import chain if any.
+func newProjectAndLicenses(gp GoPackage) ProjectAndLicenses {
+	pl := ProjectAndLicenses{
+		Project:   removeVendor(gp.PackageName),
+		Platforms: gp.Platforms,
+		Scope:     gp.Scopes,
+		Why:       gp.ImportChain,
+		Notices:   gp.Notices,
+	}
+	if m := gp.Module; m != nil && m.Main {
+		pl.Main = true
+	} else if m != nil {
+		pl.Version = m.Version
+		if m.Replace != nil {
+			pl.Replace = m.Replace.String()
+		}
+	}
+	return pl
+}
+
+// License is a license detected for a project, and how confident the
+// detection is.
+type License struct {
+	Type       string  `json:"type,omitempty"`
+	SPDXID     string  `json:"spdx_id,omitempty"`
+	Confidence float64 `json:"confidence,omitempty"`
+	// Lines is the range of lines of the license text, for files holding
+	// several licenses.
+	Lines string `json:"lines,omitempty"`
+	// Modified is set when the license text differs from the matched
+	// template outside of its variable and optional sections, even if the
+	// confidence ignoring word order is 1.
+	Modified bool `json:"modified"`
+	// Changes lists the differences with the matched template, when
+	// explanations are requested.
+	Changes []Change `json:"changes,omitempty"`
+	// Metadata holds the metadata of the matched template.
+	Metadata map[string]string `json:"metadata,omitempty"`
+	// Source is SourceReadme for licenses detected in a README file.
+	Source string `json:"source,omitempty"`
+	// Explanation details how the license was matched, when requested.
+	Explanation *Explanation `json:"explanation,omitempty"`
+}
+
+// Explanation describes the match of a license file against its best
+// template.
+type Explanation struct {
+	// Path is the license file path.
+	Path     string  `json:"path"`
+	Template string  `json:"template"`
+	Score    float64 `json:"score"`
+	// ExtraWords lists the license words absent from the template, and
+	// MissingWords the template words absent from the license, in text
+	// order.
+	ExtraWords   []string `json:"extra_words,omitempty"`
+	MissingWords []string `json:"missing_words,omitempty"`
+}
+
+// newExplanation returns the explanation of match m of the license file at
+// path.
+func newExplanation(path string, m MatchResult) *Explanation {
+	return &Explanation{
+		Path:         path,
+		Template:     m.Template.Title,
+		Score:        m.Score,
+		ExtraWords:   m.ExtraWords,
+		MissingWords: m.MissingWords,
+	}
+}
+
+func licensesToProjectAndLicenses(gPackages []GoPackage, explain bool) (c []ProjectAndLicenses, e []ProjectAndLicenses) {
+	for _, gp := range gPackages {
+		pl := newProjectAndLicenses(gp)
+		if gp.Err != "" {
+			pl.Error = gp.Err
+			e = append(e, pl)
+			continue
+		}
+		nt := 0
+		for _, rl := range gp.RawLicenses {
+			if rl.Template == nil {
+				nt++
+			}
+		}
+		if len(gp.RawLicenses) == nt {
+			pl.Error = "No license detected"
+			e = append(e, pl)
+			continue
+		}
+		ls := []License{}
+		for _, rl := range gp.RawLicenses {
+			pl.Copyrights = mergeCopyrights(pl.Copyrights, rl.Copyrights)
+			if len(rl.Segments) > 0 {
+				for _, s := range rl.Segments {
+					l := License{
+						Type:       s.Template.Title,
+						SPDXID:     s.Template.SPDXID,
+						Confidence: s.Score,
+						Lines:      fmt.Sprintf("%d-%d", s.StartLine, s.EndLine),
+						Modified:   len(s.Changes) > 0,
+						Metadata:   s.Template.Metadata,
+					}
+					if explain {
+						l.Changes = s.Changes
+						l.Explanation = newExplanation(rl.Path, s.MatchResult)
+					}
+					ls = append(ls, l)
+				}
+			} else if rl.Template.Title != "" {
+				l := License{
+					Type:       rl.Template.Title,
+					SPDXID:     rl.Template.SPDXID,
+					Confidence: rl.Score,
+					Modified:   len(rl.Changes) > 0,
+					Metadata:   rl.Template.Metadata,
+					Source:     rl.Source,
+				}
+				if rl.Source == SourceReadme {
+					l.Confidence *= readmeTrust
+				}
+				if explain {
+					l.Changes = rl.Changes
+					l.Explanation = newExplanation(rl.Path, MatchResult{
+						Template:     rl.Template,
+						Score:        rl.Score,
+						ExtraWords:   rl.ExtraWords,
+						MissingWords: rl.MissingWords,
+					})
+				}
+				ls = append(ls, l)
+			}
+		}
+		pl.Licenses = ls
+		pl.Expression = licenseExpression(ls, gp.Choice)
+		c = append(c, pl)
+	}
+	return c, e
+}
+
+func removeVendor(s string) string {
+	v := "/vendor/"
+	i := strings.Index(s, v)
+	if i == -1 {
+		return s
+	}
+	return s[i+len(v):]
+}
+
+func truncateFloat(f float64) float64 {
+	nf := fmt.Sprintf("%.3f", f)
+
+	var err error
+	f, err = strconv.ParseFloat(nf, 64)
+	if err != nil {
+		panic("unexpected parse float error")
+	}
+	return f
+}
+
+// deprecatedIDs maps the deprecated SPDX identifiers of GNU licenses to the
+// current ones.
+var deprecatedIDs = map[string]string{
+	"agpl-1.0":  "AGPL-1.0-only",
+	"agpl-3.0":  "AGPL-3.0-only",
+	"agpl-3.0+": "AGPL-3.0-or-later",
+	"gpl-1.0":   "GPL-1.0-only",
+	"gpl-1.0+":  "GPL-1.0-or-later",
+	"gpl-2.0":   "GPL-2.0-only",
+	"gpl-2.0+":  "GPL-2.0-or-later",
+	"gpl-3.0":   "GPL-3.0-only",
+	"gpl-3.0+":  "GPL-3.0-or-later",
+	"lgpl-2.0":  "LGPL-2.0-only",
+	"lgpl-2.0+": "LGPL-2.0-or-later",
+	"lgpl-2.1":  "LGPL-2.1-only",
+	"lgpl-2.1+": "LGPL-2.1-or-later",
+	"lgpl-3.0":  "LGPL-3.0-only",
+	"lgpl-3.0+": "LGPL-3.0-or-later",
+}
+
+// currentSPDXID returns the current identifier of a deprecated SPDX one, or
+// id itself.
+func currentSPDXID(id string) string {
+	if current, ok := deprecatedIDs[strings.ToLower(id)]; ok {
+		return current
+	}
+	return id
+}
+
+// currentExpression replaces the deprecated SPDX identifiers of expression e
+// with current ones.
+func currentExpression(e string) string {
+	return reLicenseID.ReplaceAllStringFunc(e, currentSPDXID)
+}
+
+// spdxFamily returns SPDX identifier id without its "-only" or "-or-later"
+// suffix. GNU license texts do not tell these variants apart, so their
+// templates declare the family identifier, like "GPL-3.0".
+func spdxFamily(id string) string {
+	for _, suffix := range []string{"-only", "-or-later"} {
+		if strings.HasSuffix(strings.ToLower(id), suffix) {
+			return id[:len(id)-len(suffix)]
+		}
+	}
+	return id
+}
+
+// resolveLicense returns supplied override license completed with the title,
+// SPDX identifier and metadata of the template it designates, by title or SPDX
+// identifier. Deprecated identifiers are replaced with current ones and the
+// "-only" or "-or-later" variant of GNU licenses is kept. Unknown licenses are
+// returned unchanged, named after their SPDX identifier if they have no type.
+func resolveLicense(l License, templates []*Template) License {
+	l.SPDXID = currentSPDXID(l.SPDXID)
+	id := currentSPDXID(l.Type)
+	if l.Type == "" {
+		id = l.SPDXID
+	}
+	for _, t := range templates {
+		if l.Type == t.Title {
+			if l.SPDXID == "" {
+				l.SPDXID = t.SPDXID
+			}
+			l.Metadata = t.Metadata
+			return l
+		}
+		if t.SPDXID != "" && strings.EqualFold(spdxFamily(id), t.SPDXID) {
+			l.Type = t.Title
+			l.SPDXID = t.SPDXID + id[len(spdxFamily(id)):]
+			l.Metadata = t.Metadata
+			return l
+		}
+	}
+	if l.Type == "" {
+		l.Type = l.SPDXID
+	}
+	return l
+}
+
+// applyOverrides replaces the licenses of detected projects with those
+// supplied in overrides. Failed projects with overridden licenses are moved to
+// the detected ones. Override licenses can be given by template title or SPDX
+// identifier.
+func applyOverrides(c, e, overrides []ProjectAndLicenses, templates []*Template) (pls []ProjectAndLicenses, ne []ProjectAndLicenses) {
+	fplm := make(map[string][]License)
+	expressions := map[string]string{}
+	for _, pl := range overrides {
+		for _, l := range pl.Licenses {
+			fplm[pl.Project] = append(fplm[pl.Project], resolveLicense(l, templates))
+		}
+		if pl.Expression != "" {
+			expressions[pl.Project] = currentExpression(pl.Expression)
+		}
+	}
+	// overridden licenses all apply, unless an expression says otherwise
+	expression := func(project string, ls []License) string {
+		if e, ok := expressions[project]; ok {
+			return e
+		}
+		return licenseExpression(ls, false)
+	}
+
+	// detected licenses
+	for _, pl := range c {
+		if fl, ok := fplm[pl.Project]; ok {
+			ls := []License{}
+			for _, l := range fl {
+				l.Confidence = 1.0
+				ls = append(ls, l)
+			}
+			pl.Licenses = ls
+			pl.Expression = expression(pl.Project, ls)
+			delete(fplm, pl.Project)
+		}
+		pls = append(pls, pl)
+	}
+	// force add undetected licenses given by overrides, keeping the module
+	// information of failed projects
+	failed := map[string]ProjectAndLicenses{}
+	for _, pl := range e {
+		failed[pl.Project] = pl
+	}
+	for proj, fl := range fplm {
+		ls := []License{}
+		for _, l := range fl {
+			l.Confidence = 1.0
+			ls = append(ls, l)
+		}
+		pl := failed[proj]
+		pl.Project = proj
+		pl.Licenses = ls
+		pl.Expression = expression(proj, ls)
+		pl.Error = ""
+		pls = append(pls, pl)
+	}
+	// missing / error license
+	for _, pl := range e {
+		if _, ok := fplm[pl.Project]; !ok {
+			ne = append(ne, pl)
+		}
+	}
+
+	sort.Slice(pls, func(i, j int) bool { return pls[i].Project < pls[j].Project })
+	sort.Slice(ne, func(i, j int) bool { return ne[i].Project < ne[j].Project })
+	return pls, ne
+}
+
+// splitUncertain separates projects with a license detected below supplied
+// confidence from the other ones. Overridden licenses are always trusted.
+func splitUncertain(pls []ProjectAndLicenses, minConfidence float64) (certain, uncertain []ProjectAndLicenses) {
+	for _, pl := range pls {
+		trusted := true
+		for _, l := range pl.Licenses {
+			if l.Confidence < minConfidence {
+				trusted = false
+				break
+			}
+		}
+		if trusted {
+			certain = append(certain, pl)
+		} else {
+			uncertain = append(uncertain, pl)
+		}
+	}
+	return certain, uncertain
+}
+
+// Report is the result of a Scan
+type Report struct {
+	// Module is the path of the scanned module, for per-module reports of a
+	// recursive scan.
+	Module string
+	// Projects lists projects with detected or overridden licenses, sorted by
+	// name.
+	Projects []ProjectAndLicenses
+	// Errors lists projects whose license could not be detected, sorted by
+	// name.
+	Errors []ProjectAndLicenses
+	// Excluded lists projects left out by include and exclude patterns,
+	// sorted by name.
+	Excluded []ProjectAndLicenses
+	// Uncertain lists projects with a license detected below the minimum
+	// confidence, sorted by name. Their best candidates are reported.
+	Uncertain []ProjectAndLicenses
+	// Obligations lists the conditions set by the licenses of Projects,
+	// sorted by name.
+	Obligations []Obligation
+	// Packages holds the packages grouped by license, with the details of
+	// their license files and matched templates.
+	Packages []GoPackage
+	// Modules holds the per-module reports of a recursive scan.
+	Modules []*Report
+}
+
+// Scan lists the packages and dependencies described by supplied options,
+// detects their licenses and groups them by project. It fails if options
+// cannot be honored together, like Binary and Tests.
+func Scan(ctx context.Context, opts Options) (*Report, error) {
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+	if opts.Recursive {
+		return scanModules(ctx, opts)
+	}
+	templates, err := opts.templates()
+	if err != nil {
+		return nil, err
+	}
+	gPackages, excluded, err := listPackagesWithLicenses(ctx, opts, templates)
+	if err != nil {
+		return nil, err
+	}
+	if gPackages, err = groupPackagesByLicense(gPackages); err != nil {
+		return nil, err
+	}
+	c, e := licensesToProjectAndLicenses(gPackages, opts.Explain)
+	pls, ne := applyOverrides(c, e, opts.Overrides, templates)
+	pls, uncertain := splitUncertain(pls, opts.MinConfidence)
+	return &Report{
+		Projects:    pls,
+		Errors:      ne,
+		Excluded:    excludedProjects(excluded),
+		Uncertain:   uncertain,
+		Obligations: findObligations(pls, templates),
+		Packages:    gPackages,
+	}, nil
+}
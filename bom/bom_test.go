@@ -0,0 +1,730 @@
+package bom
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+type testResult struct {
+	Package  string
+	Licenses []*testResultRawLicense
+	Err      string
+}
+
+type testResultRawLicense struct {
+	License string
+	Score   int
+	Extra   int
+	Missing int
+}
+
+func listTestLicenses(pkgs []string) ([]testResult, error) {
+	gopath, err := filepath.Abs("testdata")
+	if err != nil {
+		return nil, err
+	}
+	return listLicenses(Options{GOPATH: gopath, Packages: pkgs})
+}
+
+func listLicenses(opts Options) ([]testResult, error) {
+	templates, err := opts.templates()
+	if err != nil {
+		return nil, err
+	}
+	gpackages, _, err := listPackagesWithLicenses(context.Background(), opts, templates)
+	if err != nil {
+		return nil, err
+	}
+	res := []testResult{}
+	for _, gp := range gpackages {
+		tr := testResult{
+			Package: gp.PackageName,
+		}
+		trls := []*testResultRawLicense{}
+		for _, rl := range gp.RawLicenses {
+			trl := testResultRawLicense{}
+			if rl.Template != nil {
+				trl.License = rl.Template.Title
+				trl.Score = int(100 * rl.Score)
+			}
+			trl.Extra = len(rl.ExtraWords)
+			trl.Missing = len(rl.MissingWords)
+			trls = append(trls, &trl)
+		}
+		tr.Licenses = trls
+		if gp.Err != "" {
+			tr.Err = "some error"
+		}
+		res = append(res, tr)
+	}
+	return res, nil
+}
+
+func compareTestLicenses(pkgs []string, wanted []testResult) error {
+	gopath, err := filepath.Abs("testdata")
+	if err != nil {
+		return err
+	}
+	return compareLicenses(Options{GOPATH: gopath, Packages: pkgs}, wanted)
+}
+
+func compareLicenses(opts Options, wanted []testResult) error {
+	stringify := func(res []testResult) string {
+		parts := []string{}
+		for _, r := range res {
+			s := fmt.Sprintf("%s:", r.Package)
+			for i, rl := range r.Licenses {
+				if i > 0 {
+					s += ";"
+				}
+				s += fmt.Sprintf(" \"%s\" %d%%", rl.License, rl.Score)
+				if r.Err != "" {
+					s += " " + r.Err
+				}
+				if rl.Extra > 0 {
+					s += fmt.Sprintf(" +%d", rl.Extra)
+				}
+				if rl.Missing > 0 {
+					s += fmt.Sprintf(" -%d", rl.Missing)
+				}
+			}
+			parts = append(parts, s)
+		}
+		return strings.Join(parts, "\n")
+	}
+
+	licenses, err := listLicenses(opts)
+	if err != nil {
+		return err
+	}
+	got := stringify(licenses)
+	expected := stringify(wanted)
+	if got != expected {
+		return fmt.Errorf("licenses do not match:\n%s\n!=\n%s", got, expected)
+	}
+	return nil
+}
+
+// compareProjects compares the names and main flags of supplied projects.
+func compareProjects(got, wanted []ProjectAndLicenses) error {
+	s := func(pls []ProjectAndLicenses) string {
+		parts := ""
+		for _, pl := range pls {
+			parts += fmt.Sprintf("%s main=%v\n", pl.Project, pl.Main)
+		}
+		return parts
+	}
+	if s(got) != s(wanted) {
+		return fmt.Errorf("projects do not match:\n%s!=\n%s", s(got), s(wanted))
+	}
+	return nil
+}
+
+func TestNoDependencies(t *testing.T) {
+	err := compareTestLicenses([]string{"colors/red"}, []testResult{
+		{Package: "colors/red", Licenses: []*testResultRawLicense{
+			{License: "MIT License", Score: 100},
+		},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+// Multiple licenses should be detected
+func TestMultipleLicenses(t *testing.T) {
+	err := compareTestLicenses([]string{"colors/blue"}, []testResult{
+		{Package: "colors/blue", Licenses: []*testResultRawLicense{
+			{License: "MIT License", Score: 100},
+			{License: "Apache License 2.0", Score: 100}},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestNoLicense(t *testing.T) {
+	err := compareTestLicenses([]string{"colors/green"}, []testResult{
+		{Package: "colors/green", Licenses: []*testResultRawLicense{
+			{License: "", Score: 0}},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestMainWithDependencies(t *testing.T) {
+	// It also tests license retrieval in parent directory.
+	err := compareTestLicenses([]string{"colors/cmd/paint"}, []testResult{
+		{Package: "colors/cmd/paint", Licenses: []*testResultRawLicense{
+			{License: "Academic Free License v3.0", Score: 100}},
+		},
+		{Package: "colors/red", Licenses: []*testResultRawLicense{
+			{License: "MIT License", Score: 100}},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestMainWithAliasedDependencies(t *testing.T) {
+	err := compareTestLicenses([]string{"colors/cmd/mix"}, []testResult{
+		{Package: "colors/cmd/mix", Licenses: []*testResultRawLicense{
+			{License: "Academic Free License v3.0", Score: 100}},
+		},
+		{Package: "colors/red", Licenses: []*testResultRawLicense{
+			{License: "MIT License", Score: 100}},
+		},
+		{Package: "couleurs/red", Licenses: []*testResultRawLicense{
+			{License: "GNU Lesser General Public License v2.1", Score: 100}},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+// moduleEnv runs go commands in module mode, without network access.
+var moduleEnv = []string{
+	"GO111MODULE=on",
+	"GOFLAGS=",
+	"GOPROXY=off",
+	"GOWORK=off",
+}
+
+// moduleOptions returns options running go commands from supplied module
+// directory under testdata/mod, in module mode.
+func moduleOptions(name string, pkgs ...string) Options {
+	return Options{
+		Packages: pkgs,
+		Dir:      filepath.Join("testdata", "mod", name),
+		Env:      moduleEnv,
+	}
+}
+
+func TestModules(t *testing.T) {
+	// License of example.com/lib/sub is found at the replaced module root.
+	err := compareLicenses(moduleOptions("app", "./..."), []testResult{
+		{Package: "example.com/app", Licenses: []*testResultRawLicense{
+			{License: "MIT License", Score: 100}},
+		},
+		{Package: "example.com/lib/sub", Licenses: []*testResultRawLicense{
+			{License: "Apache License 2.0", Score: 100}},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestModuleVersions(t *testing.T) {
+	templates, err := loadTemplates()
+	if err != nil {
+		t.Fatal(err)
+	}
+	gpackages, _, err := listPackagesWithLicenses(context.Background(),
+		moduleOptions("app", "./..."), templates)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, e := licensesToProjectAndLicenses(gpackages, false)
+	if len(e) != 0 {
+		t.Fatalf("got %+v errors, expected nothing", e)
+	}
+	wanted := []ProjectAndLicenses{
+		{Project: "example.com/app"},
+		{Project: "example.com/lib/sub", Version: "v1.0.0", Replace: "../lib"},
+	}
+	if len(c) != len(wanted) {
+		t.Fatalf("got %d projects, expected %d", len(c), len(wanted))
+	}
+	for i, w := range wanted {
+		if c[i].Project != w.Project || c[i].Version != w.Version ||
+			c[i].Replace != w.Replace {
+			t.Errorf("#%d:\ngot      %+v,\nexpected %+v", i, c[i], w)
+		}
+	}
+}
+
+func TestMissingPackage(t *testing.T) {
+	_, err := listTestLicenses([]string{"colors/missing"})
+	if err == nil {
+		t.Fatal("no error on missing package")
+	}
+	if _, ok := err.(*MissingError); !ok {
+		t.Fatalf("MissingError expected")
+	}
+}
+
+func TestMismatch(t *testing.T) {
+	err := compareTestLicenses([]string{"colors/yellow"}, []testResult{
+		{Package: "colors/yellow", Licenses: []*testResultRawLicense{
+			{License: "Nethack General Public License", Score: 25, Extra: 95,
+				Missing: 197}},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestNoBuildableGoSourceFiles(t *testing.T) {
+	_, err := listTestLicenses([]string{"colors/cmd"})
+	if err == nil {
+		t.Fatal("no error on missing package")
+	}
+	if _, ok := err.(*MissingError); !ok {
+		t.Fatalf("MissingError expected")
+	}
+}
+
+func TestBroken(t *testing.T) {
+	err := compareTestLicenses([]string{"colors/broken"}, []testResult{
+		{Package: "colors/broken", Licenses: []*testResultRawLicense{
+			{License: "GNU General Public License v3.0", Score: 100}},
+		},
+		{Package: "colors/missing", Err: "some error", Licenses: []*testResultRawLicense{
+			{License: "", Score: 0}},
+		},
+		{Package: "colors/red", Licenses: []*testResultRawLicense{
+			{License: "MIT License", Score: 100}},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestBrokenDependency(t *testing.T) {
+
+	err := compareTestLicenses([]string{"colors/purple"}, []testResult{
+		{Package: "colors/broken", Licenses: []*testResultRawLicense{
+			{License: "GNU General Public License v3.0", Score: 100}},
+		},
+		{Package: "colors/missing", Err: "some error", Licenses: []*testResultRawLicense{
+			{License: "", Score: 0}},
+		},
+		{Package: "colors/purple", Licenses: []*testResultRawLicense{
+			{License: "", Score: 0}},
+		},
+		{Package: "colors/red", Licenses: []*testResultRawLicense{
+			{License: "MIT License", Score: 100}},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestPackageExpression(t *testing.T) {
+	err := compareTestLicenses([]string{"colors/cmd/..."}, []testResult{
+		{Package: "colors/cmd/mix", Licenses: []*testResultRawLicense{
+			{License: "Academic Free License v3.0", Score: 100}},
+		},
+		{Package: "colors/cmd/paint", Licenses: []*testResultRawLicense{
+			{License: "Academic Free License v3.0", Score: 100}},
+		},
+		{Package: "colors/red", Licenses: []*testResultRawLicense{
+			{License: "MIT License", Score: 100}},
+		},
+		{Package: "couleurs/red", Licenses: []*testResultRawLicense{
+			{License: "GNU Lesser General Public License v2.1", Score: 100}},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestCleanLicenseData(t *testing.T) {
+	data := `The MIT License (MIT)
+
+	Copyright (c) 2013 Ben Johnson
+
+	Some other lines.
+	And more.
+	`
+	cleaned := string(cleanLicenseData([]byte(data)))
+	wanted := "the mit license (mit)\n\n\tsome other lines.\n\tand more.\n\t"
+	if wanted != cleaned {
+		t.Fatalf("license data mismatch:\n%q\n!=\n%q", cleaned, wanted)
+	}
+}
+
+func TestStandardPackages(t *testing.T) {
+	err := compareTestLicenses([]string{"encoding/json", "cmd/addr2line"}, []testResult{})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestOverrides(t *testing.T) {
+	wl := []ProjectAndLicenses{
+		{Project: "colors/broken", Licenses: []License{
+			{Type: "GNU General Public License v3.0", SPDXID: "GPL-3.0", Confidence: 1}},
+			Expression: "GPL-3.0",
+		},
+		{Project: "colors/cmd/paint", Licenses: []License{
+			{Type: "Academic Free License v3.0", SPDXID: "AFL-3.0", Confidence: 1}},
+			Expression: "AFL-3.0",
+		},
+		{Project: "colors/missing", Licenses: []License{
+			{Type: "override missing", Confidence: 1}},
+			Expression: "LicenseRef-override-missing",
+		},
+		{Project: "colors/red", Licenses: []License{
+			{Type: "override existing", Confidence: 1},
+			{Type: "MIT License", SPDXID: "MIT", Confidence: 1}},
+			Expression: "LicenseRef-existing OR MIT",
+			Copyrights: []Copyright{{Statement: "Copyright (c) 2015 Patrick Mézard",
+				Years: "2015", Holders: "Patrick Mézard"}},
+		},
+	}
+	override := []ProjectAndLicenses{
+		{Project: "colors/cmd/paint", Licenses: []License{{SPDXID: "afl-3.0"}}},
+		{Project: "colors/missing", Licenses: []License{{Type: "override missing"}}},
+		{Project: "colors/red", Licenses: []License{{Type: "override existing"}, {Type: "MIT"}},
+			Expression: "LicenseRef-existing OR MIT"},
+	}
+
+	gopath, err := filepath.Abs("testdata")
+	if err != nil {
+		t.Fatal(err)
+	}
+	report, err := Scan(context.Background(), Options{
+		Packages:  []string{"colors/broken"},
+		GOPATH:    gopath,
+		Overrides: override,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, e := report.Projects, report.Errors
+	if len(e) != 0 {
+		t.Fatalf("got %+v errors, expected nothing", e)
+	}
+	for i := range c {
+		if !reflect.DeepEqual(wl[i], c[i]) {
+			t.Errorf("#%d:\ngot      %+v,\nexpected %+v", i, c[i], wl[i])
+		}
+	}
+}
+
+func TestMinConfidence(t *testing.T) {
+	gopath, err := filepath.Abs("testdata")
+	if err != nil {
+		t.Fatal(err)
+	}
+	report, err := Scan(context.Background(), Options{
+		Packages:      []string{"colors/red", "colors/yellow"},
+		GOPATH:        gopath,
+		MinConfidence: 0.9,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(report.Projects) != 1 || report.Projects[0].Project != "colors/red" {
+		t.Fatalf("unexpected projects: %+v", report.Projects)
+	}
+	if len(report.Uncertain) != 1 {
+		t.Fatalf("expected one uncertain project, got %+v", report.Uncertain)
+	}
+	pl := report.Uncertain[0]
+	if pl.Project != "colors/yellow" || len(pl.Licenses) != 1 ||
+		pl.Licenses[0].Type != "Nethack General Public License" ||
+		pl.Licenses[0].Changes != nil {
+		t.Fatalf("unexpected uncertain project: %+v", pl)
+	}
+	if len(report.Errors) != 0 {
+		t.Fatalf("got %+v errors, expected nothing", report.Errors)
+	}
+}
+
+func TestExplain(t *testing.T) {
+	gopath, err := filepath.Abs("testdata")
+	if err != nil {
+		t.Fatal(err)
+	}
+	report, err := Scan(context.Background(), Options{
+		Packages: []string{"colors/yellow"},
+		GOPATH:   gopath,
+		Explain:  true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(report.Projects) != 1 || len(report.Projects[0].Licenses) != 1 {
+		t.Fatalf("unexpected projects: %+v", report.Projects)
+	}
+	l := report.Projects[0].Licenses[0]
+	x := l.Explanation
+	if x == nil {
+		t.Fatal("explanation expected")
+	}
+	if len(l.Changes) == 0 {
+		t.Fatal("changes expected")
+	}
+	if filepath.Base(x.Path) != "COPYRIGHT" || x.Template != l.Type ||
+		x.Score != l.Confidence || len(x.ExtraWords) != 95 ||
+		len(x.MissingWords) != 197 {
+		t.Fatalf("unexpected explanation: %+v", x)
+	}
+}
+
+func TestGNUOverrides(t *testing.T) {
+	gopath, err := filepath.Abs("testdata")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		override   ProjectAndLicenses
+		ids        []string
+		expression string
+	}{
+		{ProjectAndLicenses{Licenses: []License{{SPDXID: "GPL-3.0+"}}},
+			[]string{"GPL-3.0-or-later"}, "GPL-3.0-or-later"},
+		{ProjectAndLicenses{Licenses: []License{{SPDXID: "GPL-3.0"}}},
+			[]string{"GPL-3.0-only"}, "GPL-3.0-only"},
+		{ProjectAndLicenses{Licenses: []License{{Type: "GPL-2.0+"}, {SPDXID: "LGPL-2.1+"}},
+			Expression: "GPL-2.0+ OR LGPL-2.1+"},
+			[]string{"GPL-2.0-or-later", "LGPL-2.1-or-later"}, "GPL-2.0-or-later OR LGPL-2.1-or-later"},
+		{ProjectAndLicenses{Licenses: []License{{SPDXID: "AGPL-3.0+"}, {SPDXID: "LGPL-3.0+"}}},
+			[]string{"AGPL-3.0-or-later", "LGPL-3.0-or-later"}, "AGPL-3.0-or-later AND LGPL-3.0-or-later"},
+	}
+	for i, tt := range tests {
+		tt.override.Project = "colors/broken"
+		report, err := Scan(context.Background(), Options{
+			Packages:  []string{"colors/broken"},
+			GOPATH:    gopath,
+			Overrides: []ProjectAndLicenses{tt.override},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		pl := report.Projects[0]
+		if pl.Project != "colors/broken" {
+			t.Fatalf("#%d: got %+v, expected colors/broken first", i, report.Projects)
+		}
+		ids := []string{}
+		for _, l := range pl.Licenses {
+			ids = append(ids, l.SPDXID)
+		}
+		if !reflect.DeepEqual(ids, tt.ids) || pl.Expression != tt.expression {
+			t.Errorf("#%d: got %v %q, expected %v %q", i, ids, pl.Expression, tt.ids, tt.expression)
+		}
+	}
+}
+
+func TestResolveLicense(t *testing.T) {
+	templates, err := loadTemplates()
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		l    License
+		want License
+	}{
+		{License{Type: "MIT"}, License{Type: "MIT License", SPDXID: "MIT"}},
+		{License{Type: "apache-2.0"}, License{Type: "Apache License 2.0", SPDXID: "Apache-2.0"}},
+		{License{Type: "ISC License"}, License{Type: "ISC License", SPDXID: "ISC"}},
+		{License{SPDXID: "BSD-3-Clause"}, License{
+			Type: `BSD 3-clause "New" or "Revised" License`, SPDXID: "BSD-3-Clause"}},
+		{License{SPDXID: "Zlib"}, License{Type: "zlib License", SPDXID: "Zlib"}},
+		{License{SPDXID: "CDDL-1.0"}, License{
+			Type: "Common Development and Distribution License 1.0", SPDXID: "CDDL-1.0"}},
+		{License{Type: "EPL-2.0"}, License{Type: "Eclipse Public License 2.0", SPDXID: "EPL-2.0"}},
+		{License{SPDXID: "MPL-1.1"}, License{Type: "Mozilla Public License 1.1", SPDXID: "MPL-1.1"}},
+		{License{Type: "GPL-3.0"}, License{
+			Type: "GNU General Public License v3.0", SPDXID: "GPL-3.0-only"}},
+		{License{SPDXID: "lgpl-2.1"}, License{
+			Type: "GNU Lesser General Public License v2.1", SPDXID: "LGPL-2.1-only"}},
+		{License{SPDXID: "GPL-2.0+"}, License{
+			Type: "GNU General Public License v2.0", SPDXID: "GPL-2.0-or-later"}},
+		{License{Type: "gpl-3.0-or-later"}, License{
+			Type: "GNU General Public License v3.0", SPDXID: "GPL-3.0-or-later"}},
+		{License{Type: "AGPL-3.0+"}, License{
+			Type: "GNU Affero General Public License v3.0", SPDXID: "AGPL-3.0-or-later"}},
+		{License{Type: "GNU Lesser General Public License v3.0", SPDXID: "LGPL-3.0+"}, License{
+			Type: "GNU Lesser General Public License v3.0", SPDXID: "LGPL-3.0-or-later"}},
+		{License{Type: "Proprietary"}, License{Type: "Proprietary"}},
+	}
+	for i, tt := range tests {
+		if got := resolveLicense(tt.l, templates); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("#%d: got %+v, expected %+v", i, got, tt.want)
+		}
+	}
+}
+
+func TestSPDXTemplates(t *testing.T) {
+	templates, err := loadTemplates()
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		text string
+		want string
+	}{
+		{`(C) 1995-2017 Jean-loup Gailly and Mark Adler
+
+This software is provided 'as-is', without any express or implied
+warranty. In no event will the authors be held liable for any damages
+arising from the use of this software.
+
+Permission is granted to anyone to use this software for any purpose,
+including commercial applications, and to alter it and redistribute it
+freely, subject to the following restrictions:
+
+1. The origin of this software must not be misrepresented; you must not
+   claim that you wrote the original software. If you use this software
+   in a product, an acknowledgment in the product documentation would be
+   appreciated but is not required.
+2. Altered source versions must be plainly marked as such, and must not be
+   misrepresented as being the original software.
+3. This notice may not be removed or altered from any source distribution.
+`, "Zlib"},
+		{`Copyright 2020 Someone
+
+Permission is hereby granted, free of charge, to any person obtaining a copy of this
+software and associated documentation files (the "Software"), to deal in the Software
+without restriction, including without limitation the rights to use, copy, modify,
+merge, publish, distribute, sublicense, and/or sell copies of the Software, and to
+permit persons to whom the Software is furnished to do so.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED,
+INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A
+PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE
+SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+`, "MIT-0"},
+	}
+	for i, tt := range tests {
+		m := matchTemplates([]byte(tt.text), templates)
+		if m.Template == nil {
+			t.Errorf("#%d: no template matched", i)
+		} else if m.Template.SPDXID != tt.want || m.Score < 0.9 {
+			t.Errorf("#%d: got %s at %.2f, expected %s", i, m.Template.SPDXID, m.Score, tt.want)
+		}
+	}
+	for _, templ := range templates {
+		if templ.SPDXID == "MIT-0" && !templ.OSIApproved {
+			t.Errorf("MIT-0 should be OSI approved")
+		}
+	}
+}
+
+func TestLongestPrefix(t *testing.T) {
+	tests := []struct {
+		gpackages []GoPackage
+
+		wpfx string
+	}{
+		{
+			[]GoPackage{
+				{PackageName: "a/b/c"},
+				{PackageName: "a/b/c/d"},
+			},
+			"a/b/c",
+		},
+		{
+			[]GoPackage{
+				{PackageName: "a/b/c"},
+				{PackageName: "a/b/c/d"},
+				{PackageName: "a/b/c/d/e"},
+			},
+			"a/b/c",
+		},
+		{
+			[]GoPackage{
+				{PackageName: "a/b"},
+				{PackageName: "a/b/c/d/f"},
+				{PackageName: "a/b/c/d/e"},
+			},
+			"a/b",
+		},
+	}
+
+	for i, tt := range tests {
+		if s := longestCommonPrefix(tt.gpackages); s != tt.wpfx {
+			t.Errorf("#%d: got %q, expected %q", i, s, tt.wpfx)
+		}
+	}
+}
+
+func TestTestDependencies(t *testing.T) {
+	opts := moduleOptions("app", "./...")
+	opts.Tests = true
+	report, err := Scan(context.Background(), opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// example.com/lib/sub and example.com/lib/testutil share the same license
+	// and are reported once.
+	wanted := []ProjectAndLicenses{
+		{Project: "example.com/app", Scope: []string{ScopeRuntime}},
+		{Project: "example.com/lib", Scope: []string{ScopeRuntime, ScopeTest}},
+	}
+	if len(report.Projects) != len(wanted) {
+		t.Fatalf("got %+v projects, expected %+v", report.Projects, wanted)
+	}
+	for i, w := range wanted {
+		c := report.Projects[i]
+		if c.Project != w.Project || !reflect.DeepEqual(c.Scope, w.Scope) {
+			t.Errorf("#%d:\ngot      %+v,\nexpected %+v", i, c, w)
+		}
+	}
+}
+
+func TestWhy(t *testing.T) {
+	opts := moduleOptions("app", "./...")
+	opts.Tests = true
+	opts.Why = true
+	report, err := Scan(context.Background(), opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wanted := []ProjectAndLicenses{
+		{Project: "example.com/app", Why: []string{"example.com/app"}},
+		{Project: "example.com/lib", Why: []string{"example.com/app", "example.com/lib/sub"}},
+	}
+	if len(report.Projects) != len(wanted) {
+		t.Fatalf("got %+v projects, expected %+v", report.Projects, wanted)
+	}
+	for i, w := range wanted {
+		c := report.Projects[i]
+		if c.Project != w.Project || !reflect.DeepEqual(c.Why, w.Why) {
+			t.Errorf("#%d:\ngot      %+v,\nexpected %+v", i, c, w)
+		}
+	}
+}
+
+func TestImportChains(t *testing.T) {
+	imports := map[string][]string{
+		"a": {"c", "b"},
+		"b": {"d"},
+		"c": {"d", "e"},
+		"e": {"f"},
+		"x": {"f"},
+	}
+	chains := importChains([]string{"x", "a"}, imports)
+	wanted := map[string][]string{
+		"a": {"a"},
+		"b": {"a", "b"},
+		"c": {"a", "c"},
+		"d": {"a", "b", "d"},
+		"e": {"a", "c", "e"},
+		"f": {"x", "f"},
+		"x": {"x"},
+	}
+	if !reflect.DeepEqual(chains, wanted) {
+		t.Fatalf("got %v, expected %v", chains, wanted)
+	}
+}
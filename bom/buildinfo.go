@@ -0,0 +1,121 @@
+package bom
+
+import (
+	"context"
+	"debug/buildinfo"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime/debug"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// listBinaryModules reads the build information embedded in the executable
+// designated by supplied options and returns one package per module it was
+// built with, located in the local module cache.
+func listBinaryModules(ctx context.Context, opts Options) ([]*PkgInfo, error) {
+	bi, err := buildinfo.ReadFile(opts.Binary)
+	if err != nil {
+		return nil, err
+	}
+	return listBuildInfoModules(ctx, opts, bi)
+}
+
+// listBuildInfoModules returns one package per module listed in supplied build
+// information, sorted by module path. The main module is left out unless it
+// was built at a released version, since its sources cannot be located.
+// Replacements by local directories are resolved relatively to Options.Dir.
+func listBuildInfoModules(ctx context.Context, opts Options, bi *debug.BuildInfo) ([]*PkgInfo, error) {
+	cache, err := moduleCacheDir(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	mods := []*debug.Module{}
+	if bi.Main.Path != "" && bi.Main.Version != "" && bi.Main.Version != "(devel)" {
+		mods = append(mods, &bi.Main)
+	}
+	mods = append(mods, bi.Deps...)
+
+	infos := []*PkgInfo{}
+	for _, m := range mods {
+		module := &PkgModule{
+			Path:    m.Path,
+			Version: m.Version,
+			Main:    m == &bi.Main,
+		}
+		dir := filepath.Join(cache, escapeModulePath(m.Path)+"@"+escapeModulePath(m.Version))
+		if r := m.Replace; r != nil {
+			module.Replace = &PkgModule{
+				Path:    r.Path,
+				Version: r.Version,
+			}
+			if filepath.IsAbs(r.Path) {
+				dir = r.Path
+				module.Replace.Version = ""
+			} else if isLocalModulePath(r.Path) {
+				dir = filepath.Join(opts.Dir, r.Path)
+				module.Replace.Version = ""
+			} else {
+				dir = filepath.Join(cache, escapeModulePath(r.Path)+"@"+escapeModulePath(r.Version))
+			}
+			module.Replace.Dir = dir
+		}
+		info := &PkgInfo{
+			Name:       m.Path,
+			ImportPath: m.Path,
+			Module:     module,
+		}
+		if fi, err := os.Stat(dir); err != nil || !fi.IsDir() {
+			info.Error = &PkgError{
+				Err: fmt.Sprintf("module %s not found in module cache", module),
+			}
+		} else {
+			info.Dir = dir
+			module.Dir = dir
+		}
+		infos = append(infos, info)
+	}
+	sort.Slice(infos, func(i, j int) bool {
+		return infos[i].ImportPath < infos[j].ImportPath
+	})
+	return infos, nil
+}
+
+// isLocalModulePath returns true if supplied replacement module path is a
+// relative filesystem path, following go.mod rules.
+func isLocalModulePath(path string) bool {
+	path = filepath.ToSlash(path)
+	return path == "." || path == ".." ||
+		strings.HasPrefix(path, "./") || strings.HasPrefix(path, "../")
+}
+
+// moduleCacheDir returns the module cache directory used by the go command.
+func moduleCacheDir(ctx context.Context, opts Options) (string, error) {
+	cmd := opts.goCommand(ctx, "env", "GOMODCACHE")
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		return "", fmt.Errorf("'go env GOMODCACHE' failed with:\n%s", string(out))
+	}
+	dir := strings.TrimSpace(string(out))
+	if dir == "" {
+		return "", fmt.Errorf("module cache directory is not set")
+	}
+	return dir, nil
+}
+
+// escapeModulePath returns supplied module path or version in the
+// case-insensitive form used by the module cache, where upper-case letters are
+// replaced by an exclamation mark followed by the lower-case letter.
+func escapeModulePath(s string) string {
+	b := strings.Builder{}
+	for _, r := range s {
+		if unicode.IsUpper(r) {
+			b.WriteByte('!')
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
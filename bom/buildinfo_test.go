@@ -0,0 +1,86 @@
+package bom
+
+import (
+	"context"
+	"path/filepath"
+	"runtime/debug"
+	"testing"
+)
+
+func TestBinary(t *testing.T) {
+	opts := moduleOptions("app")
+	bin := filepath.Join(t.TempDir(), "app")
+	out, err := opts.goCommand(context.Background(), "build", "-o", bin, ".").CombinedOutput()
+	if err != nil {
+		t.Fatalf("could not build test binary: %s\n%s", err, out)
+	}
+	// The main module is built from sources and left out.
+	opts.Binary = bin
+	err = compareLicenses(opts, []testResult{
+		{Package: "example.com/lib", Licenses: []*testResultRawLicense{
+			{License: "Apache License 2.0", Score: 100}},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestBinaryOptions(t *testing.T) {
+	tests := []struct {
+		opts Options
+		err  string
+	}{
+		{Options{Binary: "app", Tests: true}, "Binary cannot be combined with Tests"},
+		{Options{Binary: "app", Why: true, Platforms: []Platform{{GOOS: "linux", GOARCH: "arm64"}}},
+			"Binary cannot be combined with Why, Platforms"},
+		{Options{Binary: "app", Recursive: true}, "Binary cannot be combined with Recursive"},
+	}
+	for i, tt := range tests {
+		_, err := Scan(context.Background(), tt.opts)
+		if err == nil || err.Error() != tt.err {
+			t.Errorf("#%d: got %v, expected %q", i, err, tt.err)
+		}
+	}
+}
+
+func TestBuildInfoModules(t *testing.T) {
+	cache, err := filepath.Abs(filepath.Join("testdata", "modcache"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	opts := Options{Env: []string{"GOMODCACHE=" + cache}}
+	bi := &debug.BuildInfo{
+		Main: debug.Module{Path: "example.com/app", Version: "(devel)"},
+		Deps: []*debug.Module{
+			{Path: "example.com/Upper", Version: "v1.2.0"},
+			{Path: "example.com/lib", Version: "v1.0.0", Replace: &debug.Module{
+				Path: "example.com/fork", Version: "v0.1.0"},
+			},
+			{Path: "example.com/gone", Version: "v0.0.1"},
+		},
+	}
+	infos, err := listBuildInfoModules(context.Background(), opts, bi)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		path string
+		dir  string
+		err  bool
+	}{
+		{"example.com/Upper", filepath.Join(cache, "example.com", "!upper@v1.2.0"), false},
+		{"example.com/gone", "", true},
+		{"example.com/lib", filepath.Join(cache, "example.com", "fork@v0.1.0"), false},
+	}
+	if len(infos) != len(tests) {
+		t.Fatalf("got %d modules, expected %d", len(infos), len(tests))
+	}
+	for i, tt := range tests {
+		info := infos[i]
+		if info.ImportPath != tt.path || info.Dir != tt.dir || (info.Error != nil) != tt.err {
+			t.Errorf("#%d: got %s in %q (error: %v), expected %s in %q",
+				i, info.ImportPath, info.Dir, info.Error, tt.path, tt.dir)
+		}
+	}
+}
@@ -0,0 +1,90 @@
+package bom
+
+import (
+	"bufio"
+	"bytes"
+	"regexp"
+	"strings"
+)
+
+var (
+	// Copyright notices start with "Copyright" or a copyright sign and
+	// mention a year or a copyright sign, once comment markers are trimmed.
+	reCopyrightLine  = regexp.MustCompile(`(?i)^(?:copyright\b|\(c\)|©)`)
+	reCopyrightMark  = regexp.MustCompile(`(?i)\d{4}|\(c\)|©`)
+	reCopyrightSign  = regexp.MustCompile(`(?i)^(?:copyright\b\s*)?(?:\(c\)|©)?\s*`)
+	reCopyrightYears = regexp.MustCompile(`\d{4}(?:\s*(?:[-–,]|to)\s*(?:\d{4}|present))*`)
+	reYearHolder     = regexp.MustCompile(`(?i)[<\[{](?:year|yyyy)`)
+	reRightsReserved = regexp.MustCompile(`(?i)\.?\s*all rights reserved\.?`)
+)
+
+// Copyright is a copyright notice found in a license file.
+type Copyright struct {
+	// Statement is the notice line.
+	Statement string `json:"statement"`
+	// Years is the first year or year range of the notice.
+	Years string `json:"years,omitempty"`
+	// Holders is the notice without its copyright sign, years and rights
+	// reservation.
+	Holders string `json:"holders,omitempty"`
+}
+
+// parseCopyright returns the copyright notice of supplied license line, if
+// any. Placeholder notices of license templates are ignored.
+func parseCopyright(line string) (Copyright, bool) {
+	line = strings.TrimSpace(strings.TrimLeft(line, " \t#*/;-"))
+	line = reSpaces.ReplaceAllString(line, " ")
+	if !reCopyrightLine.MatchString(line) || !reCopyrightMark.MatchString(line) ||
+		reYearHolder.MatchString(line) {
+		return Copyright{}, false
+	}
+	c := Copyright{Statement: line}
+	rest := reCopyrightSign.ReplaceAllString(line, "")
+	if loc := reCopyrightYears.FindStringIndex(rest); loc != nil {
+		c.Years = rest[loc[0]:loc[1]]
+		rest = rest[:loc[0]] + rest[loc[1]:]
+	}
+	rest = reRightsReserved.ReplaceAllString(rest, "")
+	rest = strings.TrimSpace(strings.Trim(rest, " ,.:;"))
+	rest = strings.TrimSpace(strings.TrimPrefix(rest, "by "))
+	c.Holders = rest
+	return c, true
+}
+
+// findCopyrights returns the copyright notices of license data, in order.
+// Notices belonging to the text of template t, like the one of the Free
+// Software Foundation in GPL licenses, are ignored.
+func findCopyrights(data []byte, t *Template) []Copyright {
+	var copyrights []Copyright
+	seen := map[string]bool{}
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		c, ok := parseCopyright(scanner.Text())
+		if !ok || seen[c.Statement] {
+			continue
+		}
+		if t != nil && strings.Contains(t.text, strings.ToLower(c.Statement)) {
+			continue
+		}
+		seen[c.Statement] = true
+		copyrights = append(copyrights, c)
+	}
+	return copyrights
+}
+
+// mergeCopyrights appends the copyrights of b missing from a.
+func mergeCopyrights(a, b []Copyright) []Copyright {
+	for _, c := range b {
+		found := false
+		for _, other := range a {
+			if other.Statement == c.Statement {
+				found = true
+				break
+			}
+		}
+		if !found {
+			a = append(a, c)
+		}
+	}
+	return a
+}
@@ -0,0 +1,63 @@
+package bom
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseCopyright(t *testing.T) {
+	tests := []struct {
+		line string
+		ok   bool
+		want Copyright
+	}{
+		{"Copyright (c) 2015 Patrick Mézard", true,
+			Copyright{"Copyright (c) 2015 Patrick Mézard", "2015", "Patrick Mézard"}},
+		{"  Copyright 2009-2015 The Go Authors. All rights reserved.", true,
+			Copyright{"Copyright 2009-2015 The Go Authors. All rights reserved.",
+				"2009-2015", "The Go Authors"}},
+		{"(C) 1995-2017 Jean-loup Gailly and Mark Adler", true,
+			Copyright{"(C) 1995-2017 Jean-loup Gailly and Mark Adler", "1995-2017",
+				"Jean-loup Gailly and Mark Adler"}},
+		{"© 2014, 2016 by Jane Roe <jane@example.com>", true,
+			Copyright{"© 2014, 2016 by Jane Roe <jane@example.com>", "2014, 2016",
+				"Jane Roe <jane@example.com>"}},
+		{"# Copyright (c) ACME Corp.", true,
+			Copyright{"Copyright (c) ACME Corp.", "", "ACME Corp"}},
+		{"copyright notice, this list of conditions and the following disclaimer.", false,
+			Copyright{}},
+		{"Copyright (c) [year] [fullname]", false, Copyright{}},
+		{"Copyright [yyyy] [name of copyright owner]", false, Copyright{}},
+		{"Copyright (C) <year>  <name of author>", false, Copyright{}},
+	}
+	for i, tt := range tests {
+		got, ok := parseCopyright(tt.line)
+		if ok != tt.ok || got != tt.want {
+			t.Errorf("#%d: got %+v %v, expected %+v %v", i, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestFindCopyrights(t *testing.T) {
+	templates, err := loadTemplates()
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		path string
+		want []Copyright
+	}{
+		// The FSF notice belongs to the license text
+		{"src/colors/broken/LICENSE", nil},
+		{"src/colors/dual/LICENSE", []Copyright{
+			{"Copyright (c) 2015 Patrick Mézard", "2015", "Patrick Mézard"}}},
+	}
+	for _, tt := range tests {
+		data := []byte(readTestLicense(t, tt.path))
+		m := matchTemplates(data, templates)
+		got := findCopyrights(data, m.Template)
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: got %+v, expected %+v", tt.path, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,166 @@
+package bom
+
+import (
+	"sort"
+	"strings"
+)
+
+// templateWord is a word of a template, in text order.
+type templateWord struct {
+	Text string
+	// Var is true for a variable section, which has no text.
+	Var      bool
+	Optional bool
+}
+
+// Change describes a contiguous difference between a license and the
+// template it matches.
+type Change struct {
+	// Kind is "missing" for template text absent from the license, "altered"
+	// for template text replaced by other text and "added" for license text
+	// absent from the template.
+	Kind     string `json:"kind"`
+	Template string `json:"template,omitempty"`
+	License  string `json:"license,omitempty"`
+}
+
+// Change kinds
+const (
+	ChangeMissing = "missing"
+	ChangeAltered = "altered"
+	ChangeAdded   = "added"
+)
+
+// wordMatch is a matching block of words, a[A:A+Size] == b[B:B+Size].
+type wordMatch struct {
+	A, B, Size int
+}
+
+// wordMatcher finds the longest common blocks of two word sequences, like
+// difflib SequenceMatcher. b words occurring in more than 1% of a long b
+// sequence do not start blocks, but can extend them.
+type wordMatcher struct {
+	a   []templateWord
+	b   []string
+	b2j map[string][]int
+}
+
+func newWordMatcher(a []templateWord, b []string) *wordMatcher {
+	m := &wordMatcher{a: a, b: b, b2j: map[string][]int{}}
+	for j, w := range b {
+		m.b2j[w] = append(m.b2j[w], j)
+	}
+	if len(b) >= 200 {
+		popular := len(b)/100 + 1
+		for w, indices := range m.b2j {
+			if len(indices) > popular {
+				delete(m.b2j, w)
+			}
+		}
+	}
+	return m
+}
+
+func (m *wordMatcher) equal(i, j int) bool {
+	return !m.a[i].Var && m.a[i].Text == m.b[j]
+}
+
+// findLongestMatch returns the longest matching block in a[alo:ahi] and
+// b[blo:bhi].
+func (m *wordMatcher) findLongestMatch(alo, ahi, blo, bhi int) wordMatch {
+	best := wordMatch{A: alo, B: blo}
+	j2len := map[int]int{}
+	for i := alo; i < ahi; i++ {
+		newj2len := map[int]int{}
+		if !m.a[i].Var {
+			for _, j := range m.b2j[m.a[i].Text] {
+				if j < blo {
+					continue
+				}
+				if j >= bhi {
+					break
+				}
+				k := j2len[j-1] + 1
+				newj2len[j] = k
+				if k > best.Size {
+					best = wordMatch{A: i - k + 1, B: j - k + 1, Size: k}
+				}
+			}
+		}
+		j2len = newj2len
+	}
+	for best.A > alo && best.B > blo && m.equal(best.A-1, best.B-1) {
+		best = wordMatch{A: best.A - 1, B: best.B - 1, Size: best.Size + 1}
+	}
+	for best.A+best.Size < ahi && best.B+best.Size < bhi &&
+		m.equal(best.A+best.Size, best.B+best.Size) {
+		best.Size++
+	}
+	return best
+}
+
+// matchingBlocks returns the matching blocks of a and b, sorted and
+// terminated by an empty block at their ends.
+func (m *wordMatcher) matchingBlocks() []wordMatch {
+	blocks := []wordMatch{}
+	queue := [][4]int{{0, len(m.a), 0, len(m.b)}}
+	for len(queue) > 0 {
+		q := queue[len(queue)-1]
+		queue = queue[:len(queue)-1]
+		alo, ahi, blo, bhi := q[0], q[1], q[2], q[3]
+		x := m.findLongestMatch(alo, ahi, blo, bhi)
+		if x.Size == 0 {
+			continue
+		}
+		blocks = append(blocks, x)
+		if alo < x.A && blo < x.B {
+			queue = append(queue, [4]int{alo, x.A, blo, x.B})
+		}
+		if x.A+x.Size < ahi && x.B+x.Size < bhi {
+			queue = append(queue, [4]int{x.A + x.Size, ahi, x.B + x.Size, bhi})
+		}
+	}
+	sort.Slice(blocks, func(i, j int) bool {
+		return blocks[i].A < blocks[j].A
+	})
+	return append(blocks, wordMatch{A: len(m.a), B: len(m.b)})
+}
+
+// diffTemplate returns the changes turning the words of template t into the
+// license words. Missing variable or optional sections, and text replacing
+// them, are not changes.
+func diffTemplate(t *Template, tokens []string) []Change {
+	m := newWordMatcher(t.sequence, tokens)
+	var changes []Change
+	i, j := 0, 0
+	for _, block := range m.matchingBlocks() {
+		if i < block.A || j < block.B {
+			required := []string{}
+			markup := false
+			for _, w := range t.sequence[i:block.A] {
+				if w.Var || w.Optional {
+					markup = true
+				} else {
+					required = append(required, w.Text)
+				}
+			}
+			c := Change{
+				Template: strings.Join(required, " "),
+				License:  strings.Join(tokens[j:block.B], " "),
+			}
+			switch {
+			case c.Template != "" && c.License != "":
+				c.Kind = ChangeAltered
+			case c.Template != "":
+				c.Kind = ChangeMissing
+			case c.License != "" && !markup:
+				c.Kind = ChangeAdded
+			}
+			if c.Kind != "" {
+				changes = append(changes, c)
+			}
+		}
+		i, j = block.A+block.Size, block.B+block.Size
+	}
+	return changes
+}
@@ -0,0 +1,113 @@
+package bom
+
+import (
+	"context"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const bsd3License = `Copyright (c) 2012, The Colors Authors
+All rights reserved.
+
+Redistribution and use in source and binary forms, with or without
+modification, are permitted provided that the following conditions are met:
+
+* Redistributions of source code must retain the above copyright notice, this
+  list of conditions and the following disclaimer.
+
+* Redistributions in binary form must reproduce the above copyright notice,
+  this list of conditions and the following disclaimer in the documentation
+  and/or other materials provided with the distribution.
+
+* Neither the name of Colors nor the names of its
+  contributors may be used to endorse or promote products derived from
+  this software without specific prior written permission.
+
+THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+`
+
+func TestDiffTemplate(t *testing.T) {
+	templates, err := loadTemplates()
+	if err != nil {
+		t.Fatal(err)
+	}
+	swapped := strings.Replace(bsd3License, `* Redistributions of source code must retain the above copyright notice, this
+  list of conditions and the following disclaimer.
+
+`, "", 1)
+	swapped = strings.Replace(swapped, `
+THIS SOFTWARE`, `* Redistributions of source code must retain the above copyright notice, this
+  list of conditions and the following disclaimer.
+
+THIS SOFTWARE`, 1)
+
+	tests := []struct {
+		text    string
+		changes []Change
+	}{
+		{bsd3License, nil},
+		{strings.Replace(bsd3License, "may be used", "may not be used", 1), []Change{
+			{Kind: ChangeAdded, License: "not"},
+		}},
+		{strings.Replace(bsd3License, "without specific prior written permission",
+			"with prior notice", 1), []Change{
+			{Kind: ChangeAltered, Template: "without specific", License: "with"},
+			{Kind: ChangeAltered, Template: "written permission", License: "notice"},
+		}},
+		{swapped, []Change{
+			{Kind: ChangeMissing, Template: "of source code must retain the above " +
+				"copyright notice this list of conditions and the following " +
+				"disclaimer redistributions"},
+			{Kind: ChangeAdded, License: "redistributions of source code must " +
+				"retain the above copyright notice this list of conditions and the " +
+				"following disclaimer"},
+		}},
+	}
+	for i, tt := range tests {
+		m := matchTemplates([]byte(tt.text), templates)
+		if m.Template == nil || m.Template.SPDXID != "BSD-3-Clause" {
+			t.Fatalf("#%d: unexpected template: %v", i, m.Template)
+		}
+		if !reflect.DeepEqual(m.Changes, tt.changes) {
+			t.Errorf("#%d: unexpected changes:\n%+v\n!=\n%+v", i, m.Changes, tt.changes)
+		}
+	}
+}
+
+func TestModified(t *testing.T) {
+	gopath, err := filepath.Abs("testdata")
+	if err != nil {
+		t.Fatal(err)
+	}
+	report, err := Scan(context.Background(), Options{
+		Packages: []string{"colors/altered", "colors/red"},
+		GOPATH:   gopath,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(report.Projects) != 2 || len(report.Projects[0].Licenses) != 1 ||
+		len(report.Projects[1].Licenses) != 1 {
+		t.Fatalf("unexpected projects: %+v", report.Projects)
+	}
+	// The added "not" is already a word of the template
+	l := report.Projects[0].Licenses[0]
+	if l.SPDXID != "BSD-3-Clause" || l.Confidence < 0.99 || !l.Modified || l.Changes != nil {
+		t.Fatalf("unexpected altered license: %+v", l)
+	}
+	l = report.Projects[1].Licenses[0]
+	if l.SPDXID != "MIT" || l.Confidence != 1 || l.Modified {
+		t.Fatalf("unexpected license: %+v", l)
+	}
+}
@@ -0,0 +1,85 @@
+package bom
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+var (
+	// reChoice matches statements offering a choice between licenses, once
+	// whitespaces are collapsed.
+	reChoice = regexp.MustCompile(`(?i)dual[- ]licen[sc]ed|` +
+		`licen[sc]ed under (?:the terms of )?(?:either|your choice of|one of)|` +
+		`\bor\b.{0,80}\blicen[sc]e.{0,30}\bat your (?:option|choice)`)
+	// reLaterVersion matches the "or later" clause of GPL notices, which is
+	// not a choice between distinct licenses.
+	reLaterVersion = regexp.MustCompile(`(?i)\(?at your option\)?,? any later version`)
+	reSpaces       = regexp.MustCompile(`\s+`)
+	reReadme       = regexp.MustCompile(`(?i)^readme(?:\.[^.]+)?$`)
+	reLicenseRef   = regexp.MustCompile(`[^A-Za-z0-9.]+`)
+)
+
+// hasLicenseChoice returns true if supplied text states the licenses it
+// mentions are alternatives.
+func hasLicenseChoice(text []byte) bool {
+	s := reSpaces.ReplaceAllString(string(text), " ")
+	s = reLaterVersion.ReplaceAllString(s, "")
+	return reChoice.MatchString(s)
+}
+
+// findLicenseChoice returns true if the license files, or the README files
+// next to them, offer a choice between licenses.
+func findLicenseChoice(paths []string) (bool, error) {
+	if len(paths) == 0 || paths[0] == "" {
+		return false, nil
+	}
+	candidates := append([]string{}, paths...)
+	dir := filepath.Dir(paths[0])
+	fis, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return false, err
+	}
+	for _, fi := range fis {
+		if fi.Mode().IsRegular() && reReadme.MatchString(fi.Name()) {
+			candidates = append(candidates, filepath.Join(dir, fi.Name()))
+		}
+	}
+	for _, path := range candidates {
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			return false, err
+		}
+		if hasLicenseChoice(data) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// licenseExpression returns the SPDX license expression combining supplied
+// licenses, as alternatives if choice is true, all applying otherwise.
+// Licenses without SPDX identifier are designated by a LicenseRef- one
+// derived from their type.
+func licenseExpression(ls []License, choice bool) string {
+	ids := []string{}
+	seen := map[string]bool{}
+	for _, l := range ls {
+		id := l.SPDXID
+		if id == "" && l.Type != "" {
+			id = "LicenseRef-" + strings.Trim(
+				reLicenseRef.ReplaceAllString(l.Type, "-"), "-")
+		}
+		if id == "" || seen[id] {
+			continue
+		}
+		seen[id] = true
+		ids = append(ids, id)
+	}
+	op := " AND "
+	if choice {
+		op = " OR "
+	}
+	return strings.Join(ids, op)
+}
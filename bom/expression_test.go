@@ -0,0 +1,79 @@
+package bom
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+)
+
+func TestHasLicenseChoice(t *testing.T) {
+	tests := []struct {
+		text   string
+		choice bool
+	}{
+		{"Licensed under either of\n * Apache License, Version 2.0\n * MIT license\n\nat your option.", true},
+		{"This project is dual-licensed under the MIT and Apache 2.0 licenses.", true},
+		{"Released under the MIT or the Apache-2.0 license, at your choice.", true},
+		{"Licensed under your choice of the BSD or GPL licenses.", true},
+		{"This program is free software; you can redistribute it and/or modify\n" +
+			"it under the terms of the GNU General Public License as published by\n" +
+			"the Free Software Foundation; either version 2 of the License, or\n" +
+			"(at your option) any later version.", false},
+		{"You may charge a fee for the physical act of transferring a copy, and\n" +
+			"you may at your option offer warranty protection in exchange for a fee.", false},
+		{"The bundled colorspace package is licensed as follows:", false},
+	}
+	for i, tt := range tests {
+		if got := hasLicenseChoice([]byte(tt.text)); got != tt.choice {
+			t.Errorf("#%d: got %v, expected %v", i, got, tt.choice)
+		}
+	}
+}
+
+func TestLicenseExpression(t *testing.T) {
+	tests := []struct {
+		licenses []License
+		choice   bool
+		want     string
+	}{
+		{[]License{{SPDXID: "MIT"}}, true, "MIT"},
+		{[]License{{SPDXID: "MIT"}, {SPDXID: "Apache-2.0"}}, true, "MIT OR Apache-2.0"},
+		{[]License{{SPDXID: "MIT"}, {SPDXID: "Apache-2.0"}, {SPDXID: "MIT"}}, false,
+			"MIT AND Apache-2.0"},
+		{[]License{{Type: "ACME Corp. EULA (v2)"}, {SPDXID: "MIT"}}, false,
+			"LicenseRef-ACME-Corp.-EULA-v2 AND MIT"},
+		{[]License{}, false, ""},
+	}
+	for i, tt := range tests {
+		if got := licenseExpression(tt.licenses, tt.choice); got != tt.want {
+			t.Errorf("#%d: got %q, expected %q", i, got, tt.want)
+		}
+	}
+}
+
+func TestExpressions(t *testing.T) {
+	gopath, err := filepath.Abs("testdata")
+	if err != nil {
+		t.Fatal(err)
+	}
+	report, err := Scan(context.Background(), Options{
+		Packages: []string{"colors/dual", "colors/either", "colors/red"},
+		GOPATH:   gopath,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	wanted := map[string]string{
+		"colors/dual":   "Apache-2.0 AND MIT",
+		"colors/either": "Apache-2.0 OR MIT",
+		"colors/red":    "MIT",
+	}
+	if len(report.Projects) != len(wanted) {
+		t.Fatalf("unexpected projects: %+v", report.Projects)
+	}
+	for _, pl := range report.Projects {
+		if pl.Expression != wanted[pl.Project] {
+			t.Errorf("%s: got %q, expected %q", pl.Project, pl.Expression, wanted[pl.Project])
+		}
+	}
+}
@@ -0,0 +1,98 @@
+package bom
+
+import (
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+)
+
+// matchPattern returns true if supplied include or exclude pattern matches
+// the import path p, or one of its parent paths. Patterns follow path.Match
+// syntax and may end with "/...".
+func matchPattern(pattern, p string) (bool, error) {
+	pattern = strings.TrimSuffix(pattern, "/...")
+	for {
+		ok, err := path.Match(pattern, p)
+		if err != nil {
+			return false, fmt.Errorf("invalid pattern %q: %s", pattern, err)
+		}
+		if ok {
+			return true, nil
+		}
+		i := strings.LastIndex(p, "/")
+		if i < 0 {
+			return false, nil
+		}
+		p = p[:i]
+	}
+}
+
+// matchPackage returns true if one of supplied patterns matches the package
+// import path or its module path.
+func matchPackage(patterns []string, info *PkgInfo) (bool, error) {
+	for _, pattern := range patterns {
+		ok, err := matchPattern(pattern, info.ImportPath)
+		if err == nil && !ok && info.Module != nil {
+			ok, err = matchPattern(pattern, info.Module.Path)
+		}
+		if ok || err != nil {
+			return ok, err
+		}
+	}
+	return false, nil
+}
+
+// filterPackages splits supplied packages between those selected by include
+// and exclude patterns, and excluded ones. All packages are included if there
+// are no include patterns.
+func filterPackages(infos []*PkgInfo, include, exclude []string) (kept, excluded []*PkgInfo, err error) {
+	for _, info := range infos {
+		ok := true
+		if len(include) > 0 {
+			ok, err = matchPackage(include, info)
+			if err != nil {
+				return nil, nil, err
+			}
+		}
+		if ok && len(exclude) > 0 {
+			matched, err := matchPackage(exclude, info)
+			if err != nil {
+				return nil, nil, err
+			}
+			ok = !matched
+		}
+		if ok {
+			kept = append(kept, info)
+		} else {
+			excluded = append(excluded, info)
+		}
+	}
+	return kept, excluded, nil
+}
+
+// excludedProjects returns the projects of supplied excluded packages, named
+// after their module path if any, sorted by name.
+func excludedProjects(gPackages []GoPackage) []ProjectAndLicenses {
+	projects := map[string]*GoPackage{}
+	names := []string{}
+	for _, gp := range gPackages {
+		name := gp.PackageName
+		if gp.Module != nil {
+			name = gp.Module.Path
+		}
+		if p, ok := projects[name]; ok {
+			p.merge(gp)
+			continue
+		}
+		gp.PackageName = name
+		projects[name] = &gp
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	pls := []ProjectAndLicenses{}
+	for _, name := range names {
+		pls = append(pls, newProjectAndLicenses(*projects[name]))
+	}
+	return pls
+}
@@ -0,0 +1,75 @@
+package bom
+
+import (
+	"context"
+	"testing"
+)
+
+func TestMatchPattern(t *testing.T) {
+	tests := []struct {
+		pattern string
+		path    string
+		matched bool
+	}{
+		{"github.com/a/b", "github.com/a/b", true},
+		{"github.com/a/b", "github.com/a/b/c", true},
+		{"github.com/a/b", "github.com/a/bc", false},
+		{"github.com/a/b/...", "github.com/a/b/c/d", true},
+		{"github.com/a/*", "github.com/a/b/c", true},
+		{"github.com/*/b", "github.com/a/b/c", true},
+		{"github.com/*/b", "github.com/a/c", false},
+		{"*.internal", "corp.internal/x", true},
+	}
+	for i, tt := range tests {
+		matched, err := matchPattern(tt.pattern, tt.path)
+		if err != nil {
+			t.Errorf("#%d: unexpected error: %s", i, err)
+		} else if matched != tt.matched {
+			t.Errorf("#%d: %q matching %q: got %v, expected %v",
+				i, tt.pattern, tt.path, matched, tt.matched)
+		}
+	}
+	if _, err := matchPattern("[", "a"); err == nil {
+		t.Errorf("no error on invalid pattern")
+	}
+}
+
+func TestExclude(t *testing.T) {
+	opts := moduleOptions("app", "./...")
+	opts.Exclude = []string{"example.com/lib"}
+	report, err := Scan(context.Background(), opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = compareProjects(report.Projects, []ProjectAndLicenses{
+		{Project: "example.com/app", Main: true},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(report.Excluded) != 1 || report.Excluded[0].Project != "example.com/lib" ||
+		report.Excluded[0].Version != "v1.0.0" {
+		t.Fatalf("unexpected excluded projects: %+v", report.Excluded)
+	}
+}
+
+func TestInclude(t *testing.T) {
+	opts := moduleOptions("app", "./...")
+	opts.Include = []string{"example.com/*/sub"}
+	report, err := Scan(context.Background(), opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = compareProjects(report.Projects, []ProjectAndLicenses{
+		{Project: "example.com/lib/sub"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = compareProjects(report.Excluded, []ProjectAndLicenses{
+		{Project: "example.com/app", Main: true},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,116 @@
+package bom
+
+import (
+	"sort"
+)
+
+// commonWordRatio is the ratio of templates above which a word requiring
+// them is not discriminating enough to be indexed.
+const commonWordRatio = 0.25
+
+// templateIndex is an inverted index of the words required by templates. It
+// finds the best template matching a license like matchWords, but only
+// scores the templates sharing enough words with the license to possibly
+// beat the best score found so far.
+type templateIndex struct {
+	templates []*Template
+	// postings maps discriminating words to the indices of the templates
+	// requiring them.
+	postings map[string][]int
+	// common holds the other words, and commonCounts the number of them
+	// every template requires.
+	common       map[string]bool
+	commonCounts []int
+}
+
+func newTemplateIndex(templates []*Template) *templateIndex {
+	ix := &templateIndex{
+		templates:    templates,
+		postings:     map[string][]int{},
+		common:       map[string]bool{},
+		commonCounts: make([]int, len(templates)),
+	}
+	for i, t := range templates {
+		for w := range t.Words {
+			ix.postings[w] = append(ix.postings[w], i)
+		}
+	}
+	limit := int(commonWordRatio * float64(len(templates)))
+	for w, indices := range ix.postings {
+		if len(indices) > limit && len(indices) > 1 {
+			ix.common[w] = true
+			for _, i := range indices {
+				ix.commonCounts[i]++
+			}
+			delete(ix.postings, w)
+		}
+	}
+	return ix
+}
+
+// templateCandidate is a template and the highest score it can reach.
+type templateCandidate struct {
+	Index int
+	Bound float64
+}
+
+// match returns the best template matching license words, as matchWords
+// does with the indexed templates.
+func (ix *templateIndex) match(tokens []string) MatchResult {
+	allWords := makeWordSet(tokens)
+	shared := make([]int, len(ix.templates))
+	common := 0
+	for w := range allWords {
+		if ix.common[w] {
+			common++
+			continue
+		}
+		for _, i := range ix.postings[w] {
+			shared[i]++
+		}
+	}
+	// A template sharing c words with the license scores at most
+	// 2c/(c+|t.Words|), when the license has no other word.
+	candidates := []templateCandidate{}
+	for i, t := range ix.templates {
+		c := shared[i] + ix.commonCounts[i]
+		if common < ix.commonCounts[i] {
+			c = shared[i] + common
+		}
+		if c == 0 {
+			continue
+		}
+		candidates = append(candidates, templateCandidate{
+			Index: i,
+			Bound: 2 * float64(c) / float64(c+len(t.Words)),
+		})
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		if candidates[i].Bound != candidates[j].Bound {
+			return candidates[i].Bound > candidates[j].Bound
+		}
+		return candidates[i].Index < candidates[j].Index
+	})
+
+	best := -1
+	bestScore := float64(-1)
+	var bestExtra, bestMissing []Word
+	for _, c := range candidates {
+		if c.Bound < bestScore {
+			break
+		}
+		score, extra, missing := scoreTemplate(ix.templates[c.Index], tokens, allWords)
+		if score > bestScore || score == bestScore && c.Index < best {
+			best = c.Index
+			bestScore = score
+			bestExtra = extra
+			bestMissing = missing
+		}
+	}
+	if bestScore <= 0 {
+		// No template shares a word with the license, let matchWords pick
+		// one of the zero scores.
+		return matchWords(tokens, ix.templates)
+	}
+	return newMatchResult(ix.templates[best], bestScore, bestExtra, bestMissing)
+}
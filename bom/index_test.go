@@ -0,0 +1,83 @@
+package bom
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/pmezard/licenses/assets"
+)
+
+// indexTestLicenses returns the words of the test license files and of the
+// built-in template texts, whole and truncated.
+func indexTestLicenses(tb testing.TB) [][]string {
+	paths := []string{}
+	for _, pattern := range []string{"src/colors/*/LICENSE*", "src/colors/*/COPY*",
+		"mod/*/LICENSE", "work/*/LICENSE"} {
+		matches, err := filepath.Glob(filepath.Join("testdata", pattern))
+		if err != nil {
+			tb.Fatal(err)
+		}
+		paths = append(paths, matches...)
+	}
+	licenses := [][]string{}
+	for _, path := range paths {
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			tb.Fatal(err)
+		}
+		licenses = append(licenses, licenseWords(data))
+	}
+	for _, a := range assets.Assets {
+		words := licenseWords([]byte(a.Content))
+		licenses = append(licenses, words, words[:len(words)/3])
+	}
+	return licenses
+}
+
+func TestTemplateIndex(t *testing.T) {
+	templates, err := loadTemplates()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ix := newTemplateIndex(templates)
+	licenses := append(indexTestLicenses(t), nil, []string{"unrelated", "words"})
+	for i, words := range licenses {
+		expected := matchWords(words, templates)
+		got := ix.match(words)
+		if !reflect.DeepEqual(expected, got) {
+			t.Errorf("#%d: indexed match differs:\ngot      %s %f\nexpected %s %f",
+				i, got.Template.Title, got.Score, expected.Template.Title, expected.Score)
+		}
+	}
+}
+
+func BenchmarkMatchWords(b *testing.B) {
+	templates, err := loadTemplates()
+	if err != nil {
+		b.Fatal(err)
+	}
+	licenses := indexTestLicenses(b)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for _, words := range licenses {
+			matchWords(words, templates)
+		}
+	}
+}
+
+func BenchmarkTemplateIndex(b *testing.B) {
+	templates, err := loadTemplates()
+	if err != nil {
+		b.Fatal(err)
+	}
+	licenses := indexTestLicenses(b)
+	ix := newTemplateIndex(templates)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for _, words := range licenses {
+			ix.match(words)
+		}
+	}
+}
@@ -0,0 +1,172 @@
+package bom
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Templates may mark sections following the SPDX matching guidelines:
+//
+//	<<var;name="copyright";original="Copyright (c) <year>";match=".+">>
+//	<<beginOptional>>optional text<<endOptional>>
+//
+// Variable sections may be replaced by any text, optional ones may be
+// omitted. choosealicense placeholders like [fullname] or {year} are
+// variable sections too.
+var (
+	reMarkup      = regexp.MustCompile(`(?is)<<(beginoptional[^>]*|endoptional|var;.*?)>>`)
+	reOriginal    = regexp.MustCompile(`(?is)original="(.*?)";`)
+	rePlaceholder = regexp.MustCompile(`(?i)\[[a-z][a-z' ]*\]|\{[a-z][a-z' .]*\}`)
+)
+
+// templateNode is a section of a template body.
+type templateNode struct {
+	// Words holds the literal words of a text section, or the original
+	// words of a variable section.
+	Words    []string
+	Var      bool
+	Optional []templateNode
+}
+
+// splitText appends the literal and placeholder nodes of text to nodes.
+func splitText(nodes []templateNode, text string) []templateNode {
+	text = string(cleanLicenseData([]byte(text)))
+	for {
+		loc := rePlaceholder.FindStringIndex(text)
+		if loc == nil {
+			break
+		}
+		nodes = append(nodes,
+			templateNode{Words: reWords.FindAllString(text[:loc[0]], -1)},
+			templateNode{Words: reWords.FindAllString(text[loc[0]:loc[1]], -1), Var: true})
+		text = text[loc[1]:]
+	}
+	return append(nodes, templateNode{Words: reWords.FindAllString(text, -1)})
+}
+
+// parseMarkup parses the body of a template into a list of sections.
+func parseMarkup(body string) ([]templateNode, error) {
+	stack := [][]templateNode{nil}
+	for {
+		loc := reMarkup.FindStringSubmatchIndex(body)
+		if loc == nil {
+			break
+		}
+		n := len(stack) - 1
+		stack[n] = splitText(stack[n], body[:loc[0]])
+		tag := strings.ToLower(body[loc[2]:loc[3]])
+		body = body[loc[1]:]
+		switch {
+		case strings.HasPrefix(tag, "var;"):
+			original := ""
+			if m := reOriginal.FindStringSubmatch(tag + ";"); m != nil {
+				original = m[1]
+			}
+			stack[n] = append(stack[n], templateNode{
+				Words: reWords.FindAllString(original, -1),
+				Var:   true,
+			})
+		case strings.HasPrefix(tag, "beginoptional"):
+			stack = append(stack, nil)
+		default:
+			if n == 0 {
+				return nil, fmt.Errorf("unexpected endOptional")
+			}
+			stack[n-1] = append(stack[n-1], templateNode{Optional: stack[n]})
+			stack = stack[:n]
+		}
+	}
+	if len(stack) != 1 {
+		return nil, fmt.Errorf("unterminated beginOptional")
+	}
+	return splitText(stack[0], body), nil
+}
+
+// compileMarkup fills the required and optional words of t from its
+// sections, and builds the pattern matching its text when it has variable or
+// optional ones.
+func compileMarkup(t *Template, nodes []templateNode) error {
+	t.Words = map[string]int{}
+	t.Optional = map[string]int{}
+	pos := 0
+	markup := false
+	expr := &strings.Builder{}
+	var compile func(nodes []templateNode, optional bool)
+	compile = func(nodes []templateNode, optional bool) {
+		words := t.Words
+		if optional {
+			words = t.Optional
+		}
+		for _, n := range nodes {
+			if n.Optional != nil {
+				markup = true
+				expr.WriteString("(?:")
+				compile(n.Optional, true)
+				expr.WriteString(")?")
+				continue
+			}
+			for _, w := range n.Words {
+				if n.Var {
+					if _, ok := t.Optional[w]; !ok {
+						t.Optional[w] = pos
+					}
+				} else {
+					if _, ok := words[w]; !ok {
+						words[w] = pos
+					}
+					expr.WriteString(" " + regexp.QuoteMeta(w))
+					t.sequence = append(t.sequence, templateWord{
+						Text:     w,
+						Optional: optional,
+					})
+				}
+				pos++
+			}
+			if n.Var {
+				markup = true
+				expr.WriteString(`(?: [^ ]+)*?`)
+				t.sequence = append(t.sequence, templateWord{Var: true})
+			}
+		}
+	}
+	compile(nodes, false)
+	for w := range t.Words {
+		delete(t.Optional, w)
+	}
+	if !markup || len(t.Words) == 0 {
+		return nil
+	}
+	pattern, err := regexp.Compile(expr.String() + `(?: |$)`)
+	if err != nil {
+		return err
+	}
+	t.pattern = pattern
+	return nil
+}
+
+// matchMarkup matches the license words against the pattern of t. If the
+// template text is found, it returns the license words outside of it,
+// otherwise ok is false.
+func matchMarkup(t *Template, tokens []string) (outside []string, ok bool) {
+	if t.pattern == nil {
+		return nil, false
+	}
+	offsets := make([]int, len(tokens))
+	text := &strings.Builder{}
+	for i, w := range tokens {
+		offsets[i] = text.Len()
+		text.WriteString(" " + w)
+	}
+	loc := t.pattern.FindStringIndex(text.String())
+	if loc == nil {
+		return nil, false
+	}
+	outside = []string{}
+	for i, w := range tokens {
+		if offsets[i] < loc[0] || offsets[i]+1+len(w) > loc[1] {
+			outside = append(outside, w)
+		}
+	}
+	return outside, true
+}
@@ -0,0 +1,84 @@
+package bom
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func sortedKeys(m map[string]int) []string {
+	keys := []string{}
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func TestParseMarkup(t *testing.T) {
+	templ, err := parseTemplate(`---
+title: Test License
+---
+
+<<beginOptional>>Test License<<endOptional>>
+
+Copyright [year] [fullname]
+
+Permission is granted to <<var;name="licensee";original="any person";match=".+">>
+to use this software<<beginOptional>>, free of charge<<endOptional>>.
+`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	words := sortedKeys(templ.Words)
+	wanted := []string{"granted", "is", "permission", "software", "this", "to", "use"}
+	if !reflect.DeepEqual(words, wanted) {
+		t.Fatalf("unexpected words: %v != %v", words, wanted)
+	}
+	optional := sortedKeys(templ.Optional)
+	wanted = []string{"any", "charge", "free", "license", "of", "person", "test"}
+	if !reflect.DeepEqual(optional, wanted) {
+		t.Fatalf("unexpected optional words: %v != %v", optional, wanted)
+	}
+
+	_, err = parseTemplate("---\ntitle: Broken\n---\n<<beginOptional>>text\n")
+	if err == nil {
+		t.Fatal("unterminated optional section did not fail")
+	}
+}
+
+func TestMatchMarkup(t *testing.T) {
+	templ, err := parseTemplate(`---
+title: Test License
+---
+
+<<beginOptional>>Test License<<endOptional>>
+
+Permission is granted to <<var;name="licensee";original="any person";match=".+">>
+to use this software<<beginOptional>>, free of charge<<endOptional>>.
+`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		text  string
+		score float64
+		extra []string
+	}{
+		{"Permission is granted to any person to use this software.", 1, []string{}},
+		{"Test License\nPermission is granted to John Doe and Jane Roe\n" +
+			"to use this software, free of charge.", 1, []string{}},
+		{"Preamble. Permission is granted to everybody to use this software.",
+			2 * 7. / (2*7 + 1), []string{"preamble"}},
+		// Variable sections only hold text between matched words
+		{"Permission is granted to to use this nice software.",
+			2 * 7. / (7 + 8), []string{"nice"}},
+	}
+	for i, tt := range tests {
+		m := matchTemplates([]byte(tt.text), []*Template{templ})
+		if m.Score != tt.score || !reflect.DeepEqual(m.ExtraWords, tt.extra) {
+			t.Errorf("#%d: got %f %v, expected %f %v", i, m.Score, m.ExtraWords,
+				tt.score, tt.extra)
+		}
+	}
+}
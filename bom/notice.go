@@ -0,0 +1,40 @@
+package bom
+
+import (
+	"io/ioutil"
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+// reNotice matches the names of NOTICE files, whose content licenses like
+// Apache-2.0 require to redistribute along with the software.
+var reNotice = regexp.MustCompile(`(?i)^notice(?:\.(?:txt|md))?$`)
+
+// readNotice returns the text of the NOTICE file at path, without trailing
+// whitespaces.
+func readNotice(path string) (string, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimRightFunc(string(data), unicode.IsSpace), nil
+}
+
+// mergeNotices appends the notices of b missing from a, keeping their
+// discovery order, from the package directory up.
+func mergeNotices(a, b []string) []string {
+	for _, n := range b {
+		found := false
+		for _, other := range a {
+			if other == n {
+				found = true
+				break
+			}
+		}
+		if !found {
+			a = append(a, n)
+		}
+	}
+	return a
+}
@@ -0,0 +1,84 @@
+package bom
+
+import (
+	"context"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestNotices(t *testing.T) {
+	gopath, err := filepath.Abs("testdata")
+	if err != nil {
+		t.Fatal(err)
+	}
+	report, err := Scan(context.Background(), Options{
+		Packages: []string{"colors/notice"},
+		GOPATH:   gopath,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(report.Errors) != 0 || len(report.Projects) != 1 {
+		t.Fatalf("unexpected report: %+v", report)
+	}
+	pl := report.Projects[0]
+	wn := []string{
+		"Notice\nCopyright 2016 The Notice Authors\n\n" +
+			"This product includes software developed at\n" +
+			"The Colors Foundation (http://colors.example/).",
+		"The sub package bundles the palette of Example Inc.",
+	}
+	if pl.Project != "colors/notice" || !reflect.DeepEqual(wn, pl.Notices) {
+		t.Fatalf("unexpected notices for %s: %q", pl.Project, pl.Notices)
+	}
+}
+
+func TestRootNotice(t *testing.T) {
+	gopath, err := filepath.Abs("testdata")
+	if err != nil {
+		t.Fatal(err)
+	}
+	report, err := Scan(context.Background(), Options{
+		Packages: []string{"colors/layered/vendored"},
+		GOPATH:   gopath,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(report.Errors) != 0 || len(report.Projects) != 1 {
+		t.Fatalf("unexpected report: %+v", report)
+	}
+	pl := report.Projects[0]
+	wn := []string{
+		"This package vendors the Example Inc. shades.",
+		"Layered\nCopyright 2017 The Layered Authors",
+	}
+	if pl.Project != "colors/layered/vendored" || pl.Expression != "MIT" ||
+		!reflect.DeepEqual(wn, pl.Notices) {
+		t.Fatalf("unexpected project: %+v", pl)
+	}
+}
+
+func TestProjectRootNotice(t *testing.T) {
+	gopath, err := filepath.Abs("testdata")
+	if err != nil {
+		t.Fatal(err)
+	}
+	report, err := Scan(context.Background(), Options{
+		Packages: []string{"github.com/acme/paint/brush"},
+		GOPATH:   gopath,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(report.Errors) != 0 || len(report.Projects) != 1 {
+		t.Fatalf("unexpected report: %+v", report)
+	}
+	// The NOTICE of github.com/acme is above the repository root
+	pl := report.Projects[0]
+	wn := []string{"Paint\nCopyright 2018 The Paint Authors"}
+	if pl.Project != "github.com/acme/paint/brush" || !reflect.DeepEqual(wn, pl.Notices) {
+		t.Fatalf("unexpected project: %+v", pl)
+	}
+}
@@ -0,0 +1,227 @@
+package bom
+
+import (
+	"sort"
+	"strings"
+)
+
+// Obligation is a condition set by the licenses of scanned projects, like
+// "include-copyright" or "disclose-source".
+type Obligation struct {
+	Name string `json:"obligation"`
+	// Licenses lists the titles of the licenses setting the obligation.
+	Licenses []string `json:"licenses"`
+	// Projects lists the projects distributed under these licenses.
+	Projects []string `json:"projects"`
+}
+
+// spdxObligations lists the conditions of the built-in licenses whose
+// template declares none, like the ones imported from the SPDX list, by SPDX
+// identifier. They use the choosealicense names. Licenses without conditions
+// have an empty list, every built-in template must have conditions.
+var spdxObligations = map[string][]string{
+	"0BSD":                       {},
+	"AFL-1.1":                    {"include-copyright"},
+	"AFL-1.2":                    {"include-copyright"},
+	"AFL-2.0":                    {"include-copyright"},
+	"AFL-2.1":                    {"include-copyright"},
+	"AGPL-1.0":                   {"include-copyright", "document-changes", "disclose-source", "network-use-disclose"},
+	"AML":                        {"include-copyright"},
+	"AMPAS":                      {"include-copyright"},
+	"Apache-1.0":                 {"include-copyright"},
+	"Apache-1.1":                 {"include-copyright"},
+	"APSL-1.0":                   {"include-copyright", "document-changes", "disclose-source"},
+	"APSL-1.1":                   {"include-copyright", "document-changes", "disclose-source"},
+	"APSL-1.2":                   {"include-copyright", "document-changes", "disclose-source"},
+	"APSL-2.0":                   {"include-copyright", "document-changes", "disclose-source"},
+	"Artistic-1.0":               {"include-copyright", "document-changes"},
+	"Artistic-1.0-cl8":           {"include-copyright", "document-changes"},
+	"Artistic-1.0-Perl":          {"include-copyright", "document-changes"},
+	"Beerware":                   {"include-copyright"},
+	"BitTorrent-1.1":             {"include-copyright", "document-changes", "disclose-source"},
+	"blessing":                   {},
+	"BSD-2-Clause-Patent":        {"include-copyright"},
+	"BSD-3-Clause-Attribution":   {"include-copyright"},
+	"BSD-3-Clause-LBNL":          {"include-copyright"},
+	"BSD-4-Clause":               {"include-copyright"},
+	"BSD-4-Clause-UC":            {"include-copyright"},
+	"BSD-Protection":             {"include-copyright", "disclose-source"},
+	"BSD-Source-Code":            {"include-copyright"},
+	"BSL-1.0":                    {"include-copyright"},
+	"CAL-1.0":                    {"include-copyright", "disclose-source", "network-use-disclose"},
+	"CC-BY-1.0":                  {"include-copyright", "document-changes"},
+	"CC-BY-2.0":                  {"include-copyright", "document-changes"},
+	"CC-BY-2.5":                  {"include-copyright", "document-changes"},
+	"CC-BY-3.0":                  {"include-copyright", "document-changes"},
+	"CC-BY-4.0":                  {"include-copyright", "document-changes"},
+	"CC-BY-NC-1.0":               {"include-copyright", "document-changes"},
+	"CC-BY-NC-2.0":               {"include-copyright", "document-changes"},
+	"CC-BY-NC-2.5":               {"include-copyright", "document-changes"},
+	"CC-BY-NC-3.0":               {"include-copyright", "document-changes"},
+	"CC-BY-NC-4.0":               {"include-copyright", "document-changes"},
+	"CC-BY-NC-ND-1.0":            {"include-copyright"},
+	"CC-BY-NC-ND-2.0":            {"include-copyright"},
+	"CC-BY-NC-ND-2.5":            {"include-copyright"},
+	"CC-BY-NC-ND-3.0":            {"include-copyright"},
+	"CC-BY-NC-ND-4.0":            {"include-copyright"},
+	"CC-BY-NC-SA-1.0":            {"include-copyright", "document-changes"},
+	"CC-BY-NC-SA-2.0":            {"include-copyright", "document-changes"},
+	"CC-BY-NC-SA-2.5":            {"include-copyright", "document-changes"},
+	"CC-BY-NC-SA-3.0":            {"include-copyright", "document-changes"},
+	"CC-BY-NC-SA-4.0":            {"include-copyright", "document-changes"},
+	"CC-BY-ND-1.0":               {"include-copyright"},
+	"CC-BY-ND-2.0":               {"include-copyright"},
+	"CC-BY-ND-2.5":               {"include-copyright"},
+	"CC-BY-ND-3.0":               {"include-copyright"},
+	"CC-BY-ND-4.0":               {"include-copyright"},
+	"CC-BY-SA-1.0":               {"include-copyright", "document-changes"},
+	"CC-BY-SA-2.0":               {"include-copyright", "document-changes"},
+	"CC-BY-SA-2.5":               {"include-copyright", "document-changes"},
+	"CC-BY-SA-3.0":               {"include-copyright", "document-changes"},
+	"CC-BY-SA-4.0":               {"include-copyright", "document-changes"},
+	"CC0-1.0":                    {},
+	"CDDL-1.0":                   {"include-copyright", "disclose-source"},
+	"CDDL-1.1":                   {"include-copyright", "disclose-source"},
+	"CDLA-Permissive-1.0":        {"include-copyright"},
+	"CECILL-2.1":                 {"include-copyright", "disclose-source"},
+	"Clips":                      {"include-copyright"},
+	"CNRI-Python-GPL-Compatible": {"include-copyright", "document-changes"},
+	"CPAL-1.0":                   {"include-copyright", "document-changes", "disclose-source", "network-use-disclose"},
+	"CPL-1.0":                    {"include-copyright", "disclose-source"},
+	"curl":                       {"include-copyright"},
+	"DRL-1.0":                    {"include-copyright"},
+	"eGenix":                     {"include-copyright"},
+	"Elastic-2.0":                {"include-copyright"},
+	"EPL-2.0":                    {"include-copyright", "disclose-source"},
+	"EUPL-1.0":                   {"include-copyright", "document-changes", "disclose-source", "network-use-disclose"},
+	"EUPL-1.1":                   {"include-copyright", "document-changes", "disclose-source", "network-use-disclose"},
+	"FreeImage":                  {"include-copyright", "document-changes", "disclose-source"},
+	"FTL":                        {"include-copyright"},
+	"GPL-1.0":                    {"include-copyright", "document-changes", "disclose-source"},
+	"hdparm":                     {"include-copyright"},
+	"HPND-sell-variant":          {"include-copyright"},
+	"ICU":                        {"include-copyright"},
+	"IJG":                        {"include-copyright", "document-changes"},
+	"ImageMagick":                {"include-copyright", "document-changes"},
+	"Info-ZIP":                   {"include-copyright", "document-changes"},
+	"IPL-1.0":                    {"include-copyright", "disclose-source"},
+	"JSON":                       {"include-copyright"},
+	"LGPL-2.0":                   {"include-copyright", "library-usage", "disclose-source"},
+	"LGPLLR":                     {"include-copyright", "document-changes", "disclose-source"},
+	"Libpng":                     {"include-copyright", "document-changes"},
+	"libtiff":                    {"include-copyright"},
+	"Linux-OpenIB":               {"include-copyright"},
+	"LPL-1.0":                    {"include-copyright", "disclose-source"},
+	"LPL-1.02":                   {"include-copyright", "disclose-source"},
+	"LPPL-1.3c":                  {"include-copyright", "document-changes"},
+	"MIT-0":                      {},
+	"MIT-Modern-Variant":         {"include-copyright"},
+	"MPL-1.0":                    {"include-copyright", "disclose-source"},
+	"MPL-1.1":                    {"include-copyright", "disclose-source"},
+	"NAIST-2003":                 {"include-copyright"},
+	"NCSA":                       {"include-copyright"},
+	"NGPL":                       {"include-copyright", "document-changes", "disclose-source"},
+	"NPL-1.0":                    {"include-copyright", "disclose-source"},
+	"NPL-1.1":                    {"include-copyright", "disclose-source"},
+	"OpenSSL":                    {"include-copyright"},
+	"OpenVision":                 {"include-copyright"},
+	"OSL-1.0":                    {"include-copyright", "disclose-source"},
+	"OSL-1.1":                    {"include-copyright", "disclose-source"},
+	"OSL-2.0":                    {"include-copyright", "disclose-source"},
+	"OSL-2.1":                    {"include-copyright", "disclose-source"},
+	"PHP-3.0":                    {"include-copyright"},
+	"PHP-3.01":                   {"include-copyright"},
+	"PostgreSQL":                 {"include-copyright"},
+	"Python-2.0":                 {"include-copyright", "document-changes"},
+	"Qhull":                      {"include-copyright", "document-changes"},
+	"QPL-1.0":                    {"include-copyright", "document-changes", "disclose-source"},
+	"Ruby":                       {"include-copyright", "document-changes"},
+	"SGI-B-1.0":                  {"include-copyright"},
+	"SGI-B-1.1":                  {"include-copyright"},
+	"SGI-B-2.0":                  {"include-copyright"},
+	"SISSL":                      {"include-copyright", "disclose-source"},
+	"SISSL-1.2":                  {"include-copyright", "disclose-source"},
+	"Sleepycat":                  {"include-copyright", "disclose-source"},
+	"Spencer-86":                 {"include-copyright", "document-changes"},
+	"SSPL-1.0":                   {"include-copyright", "disclose-source", "network-use-disclose"},
+	"SunPro":                     {"include-copyright"},
+	"Unicode-DFS-2015":           {"include-copyright"},
+	"Unicode-DFS-2016":           {"include-copyright"},
+	"Unicode-TOU":                {"include-copyright"},
+	"Unlicense":                  {},
+	"UPL-1.0":                    {"include-copyright"},
+	"Vim":                        {"include-copyright", "disclose-source"},
+	"W3C":                        {"include-copyright", "document-changes"},
+	"W3C-19980720":               {"include-copyright", "document-changes"},
+	"W3C-20150513":               {"include-copyright", "document-changes"},
+	"WTFPL":                      {},
+	"X11":                        {"include-copyright"},
+	"Xnet":                       {"include-copyright"},
+	"Zend-2.0":                   {"include-copyright"},
+	"Zlib":                       {"include-copyright", "document-changes"},
+	"zlib-acknowledgement":       {"include-copyright", "document-changes"},
+	"ZPL-1.1":                    {"include-copyright"},
+	"ZPL-2.0":                    {"include-copyright"},
+	"ZPL-2.1":                    {"include-copyright"},
+}
+
+// requiredObligations returns the conditions of template t, from its front
+// matter or from its SPDX identifier.
+func requiredObligations(t *Template) []string {
+	if len(t.Required) > 0 {
+		return t.Required
+	}
+	return spdxObligations[spdxFamily(t.SPDXID)]
+}
+
+// findObligations returns the obligations of the licenses of supplied
+// projects, sorted by name. License templates are designated by SPDX
+// identifier, or by title for licenses without one. Projects offering a
+// choice between licenses are reported with the obligations of every
+// license.
+func findObligations(pls []ProjectAndLicenses, templates []*Template) []Obligation {
+	byID := map[string]*Template{}
+	byTitle := map[string]*Template{}
+	for _, t := range templates {
+		if t.SPDXID != "" {
+			byID[strings.ToLower(spdxFamily(t.SPDXID))] = t
+		}
+		byTitle[t.Title] = t
+	}
+	obligations := []Obligation{}
+	for _, pl := range pls {
+		for _, l := range pl.Licenses {
+			t, ok := byID[strings.ToLower(spdxFamily(l.SPDXID))]
+			if !ok {
+				t, ok = byTitle[l.Type]
+			}
+			if !ok {
+				continue
+			}
+			for _, name := range requiredObligations(t) {
+				obligations = mergeObligations(obligations, []Obligation{{
+					Name:     name,
+					Licenses: []string{t.Title},
+					Projects: []string{pl.Project},
+				}})
+			}
+		}
+	}
+	return obligations
+}
+
+// mergeObligations returns the union of supplied obligations, sorted by
+// name.
+func mergeObligations(a, b []Obligation) []Obligation {
+	for _, o := range b {
+		i := sort.Search(len(a), func(i int) bool { return a[i].Name >= o.Name })
+		if i == len(a) || a[i].Name != o.Name {
+			a = append(a, Obligation{})
+			copy(a[i+1:], a[i:])
+			a[i] = Obligation{Name: o.Name}
+		}
+		a[i].Licenses = mergeStrings(a[i].Licenses, o.Licenses)
+		a[i].Projects = mergeStrings(a[i].Projects, o.Projects)
+	}
+	return a
+}
@@ -0,0 +1,133 @@
+package bom
+
+import (
+	"context"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestParseObligations(t *testing.T) {
+	templates, err := loadTemplates()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, tmpl := range templates {
+		if tmpl.SPDXID != "MIT" {
+			continue
+		}
+		if !reflect.DeepEqual(tmpl.Required, []string{"include-copyright"}) {
+			t.Errorf("unexpected required: %q", tmpl.Required)
+		}
+		if !reflect.DeepEqual(tmpl.Forbidden, []string{"no-liability"}) {
+			t.Errorf("unexpected forbidden: %q", tmpl.Forbidden)
+		}
+		if len(tmpl.Permitted) != 5 {
+			t.Errorf("unexpected permitted: %q", tmpl.Permitted)
+		}
+		return
+	}
+	t.Fatal("MIT template not found")
+}
+
+func TestObligations(t *testing.T) {
+	gopath, err := filepath.Abs("testdata")
+	if err != nil {
+		t.Fatal(err)
+	}
+	report, err := Scan(context.Background(), Options{
+		Packages: []string{"colors/blue", "colors/red"},
+		GOPATH:   gopath,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	wo := []Obligation{
+		{
+			Name:     "document-changes",
+			Licenses: []string{"Apache License 2.0"},
+			Projects: []string{"colors/blue"},
+		},
+		{
+			Name:     "include-copyright",
+			Licenses: []string{"Apache License 2.0", "MIT License"},
+			Projects: []string{"colors/blue", "colors/red"},
+		},
+	}
+	if !reflect.DeepEqual(wo, report.Obligations) {
+		t.Fatalf("got      %+v,\nexpected %+v", report.Obligations, wo)
+	}
+}
+
+func TestSPDXObligations(t *testing.T) {
+	templates, err := loadTemplates()
+	if err != nil {
+		t.Fatal(err)
+	}
+	pls := []ProjectAndLicenses{
+		{Project: "colors/apache", Licenses: []License{
+			{Type: "Apache License 1.1", SPDXID: "Apache-1.1"}}},
+		{Project: "colors/zlib", Licenses: []License{
+			{Type: "zlib License", SPDXID: "Zlib"}}},
+	}
+	wo := []Obligation{
+		{
+			Name:     "document-changes",
+			Licenses: []string{"zlib License"},
+			Projects: []string{"colors/zlib"},
+		},
+		{
+			Name:     "include-copyright",
+			Licenses: []string{"Apache License 1.1", "zlib License"},
+			Projects: []string{"colors/apache", "colors/zlib"},
+		},
+	}
+	if o := findObligations(pls, templates); !reflect.DeepEqual(wo, o) {
+		t.Fatalf("got      %+v,\nexpected %+v", o, wo)
+	}
+}
+
+func TestBuiltinObligations(t *testing.T) {
+	templates, err := loadTemplates()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ids := map[string]bool{}
+	for _, tpl := range templates {
+		if tpl.SPDXID == "" {
+			continue
+		}
+		ids[tpl.SPDXID] = true
+		if _, ok := spdxObligations[tpl.SPDXID]; len(tpl.Required) == 0 && !ok {
+			t.Errorf("%s has no obligations", tpl.SPDXID)
+		}
+	}
+	for id := range spdxObligations {
+		if !ids[id] {
+			t.Errorf("obligations of %s have no template", id)
+		}
+	}
+}
+
+func TestCustomTemplateObligations(t *testing.T) {
+	gopath, err := filepath.Abs("testdata")
+	if err != nil {
+		t.Fatal(err)
+	}
+	report, err := Scan(context.Background(), Options{
+		Packages:    []string{"colors/red"},
+		GOPATH:      gopath,
+		TemplateDir: filepath.Join(gopath, "templates"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	wo := []Obligation{{
+		Name:     "include-copyright",
+		Licenses: []string{"MIT License"},
+		Projects: []string{"colors/red"},
+	}}
+	if !reflect.DeepEqual(wo, report.Obligations) {
+		t.Fatalf("got      %+v,\nexpected %+v", report.Obligations, wo)
+	}
+}
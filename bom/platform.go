@@ -0,0 +1,122 @@
+package bom
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Platform is a target operating system and architecture, with optional build
+// tags, for which dependencies are resolved.
+type Platform struct {
+	GOOS   string
+	GOARCH string
+	// Tags lists additional build tags. The "cgo" tag enables cgo, which is
+	// otherwise disabled by go commands when cross-compiling.
+	Tags []string
+}
+
+// ParsePlatform parses a platform written as "goos/goarch", optionally
+// followed by a colon and a comma separated list of build tags, like
+// "linux/amd64:cgo,netgo".
+func ParsePlatform(s string) (Platform, error) {
+	p := Platform{}
+	target, tags := s, ""
+	if i := strings.Index(s, ":"); i >= 0 {
+		target, tags = s[:i], s[i+1:]
+	}
+	parts := strings.Split(target, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return p, fmt.Errorf("invalid platform %q, expected goos/goarch[:tags]", s)
+	}
+	p.GOOS, p.GOARCH = parts[0], parts[1]
+	for _, t := range strings.Split(tags, ",") {
+		if t = strings.TrimSpace(t); t != "" {
+			p.Tags = append(p.Tags, t)
+		}
+	}
+	return p, nil
+}
+
+// String returns the platform in the form accepted by ParsePlatform.
+func (p Platform) String() string {
+	s := p.GOOS + "/" + p.GOARCH
+	if len(p.Tags) > 0 {
+		s += ":" + strings.Join(p.Tags, ",")
+	}
+	return s
+}
+
+// options returns a copy of supplied options targeting the platform.
+func (p Platform) options(opts Options) Options {
+	env := append([]string{}, opts.Env...)
+	env = append(env, "GOOS="+p.GOOS, "GOARCH="+p.GOARCH)
+	tags := append([]string{}, opts.Tags...)
+	for _, t := range p.Tags {
+		if t == "cgo" {
+			env = append(env, "CGO_ENABLED=1")
+			continue
+		}
+		tags = append(tags, t)
+	}
+	opts.Env = env
+	opts.Tags = tags
+	return opts
+}
+
+// listPlatformPackages returns the packages and dependencies listed for every
+// platform of supplied options, or for the host platform if there are none.
+// Each package is annotated with the platforms pulling it in.
+func listPlatformPackages(ctx context.Context, opts Options) ([]*PkgInfo, error) {
+	if len(opts.Platforms) == 0 {
+		return listPackagesAndDeps(ctx, opts)
+	}
+	merged := map[string]*PkgInfo{}
+	for _, p := range opts.Platforms {
+		infos, err := listPackagesAndDeps(ctx, p.options(opts))
+		if err != nil {
+			if _, ok := err.(*MissingError); ok {
+				return nil, err
+			}
+			return nil, fmt.Errorf("%s: %s", p, err)
+		}
+		for _, info := range infos {
+			if m, ok := merged[info.ImportPath]; ok {
+				m.Scopes = mergeStrings(m.Scopes, info.Scopes)
+				m.ImportChain = shortestChain(m.ImportChain, info.ImportChain)
+				info = m
+			} else {
+				merged[info.ImportPath] = info
+			}
+			info.Platforms = mergeStrings(info.Platforms, []string{p.String()})
+		}
+	}
+	infos := make([]*PkgInfo, 0, len(merged))
+	for _, info := range merged {
+		infos = append(infos, info)
+	}
+	sort.Slice(infos, func(i, j int) bool {
+		return infos[i].ImportPath < infos[j].ImportPath
+	})
+	return infos, nil
+}
+
+// mergeStrings returns the sorted union of supplied string slices.
+func mergeStrings(a, b []string) []string {
+	if len(b) == 0 {
+		return a
+	}
+	seen := map[string]bool{}
+	merged := []string{}
+	for _, l := range [][]string{a, b} {
+		for _, s := range l {
+			if !seen[s] {
+				seen[s] = true
+				merged = append(merged, s)
+			}
+		}
+	}
+	sort.Strings(merged)
+	return merged
+}
@@ -0,0 +1,81 @@
+package bom
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+func TestPlatforms(t *testing.T) {
+	opts := moduleOptions("app", "./...")
+	for _, s := range []string{"linux/amd64", "windows/amd64"} {
+		p, err := ParsePlatform(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		opts.Platforms = append(opts.Platforms, p)
+	}
+	report, err := Scan(context.Background(), opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wanted := []ProjectAndLicenses{
+		{Project: "example.com/app", Platforms: []string{"linux/amd64", "windows/amd64"}},
+		{Project: "example.com/lib/sub", Platforms: []string{"linux/amd64", "windows/amd64"}},
+		{Project: "example.com/lib/win", Platforms: []string{"windows/amd64"}},
+	}
+	if len(report.Projects) != len(wanted) {
+		t.Fatalf("got %d projects, expected %d", len(report.Projects), len(wanted))
+	}
+	for i, w := range wanted {
+		c := report.Projects[i]
+		if c.Project != w.Project || !reflect.DeepEqual(c.Platforms, w.Platforms) {
+			t.Errorf("#%d:\ngot      %+v,\nexpected %+v", i, c, w)
+		}
+	}
+}
+
+func TestParsePlatform(t *testing.T) {
+	tests := []struct {
+		s    string
+		want Platform
+		err  bool
+	}{
+		{"linux/amd64", Platform{GOOS: "linux", GOARCH: "amd64"}, false},
+		{"windows/arm64:cgo,netgo", Platform{GOOS: "windows", GOARCH: "arm64",
+			Tags: []string{"cgo", "netgo"}}, false},
+		{"linux", Platform{}, true},
+		{"/amd64:cgo", Platform{}, true},
+	}
+	for i, tt := range tests {
+		p, err := ParsePlatform(tt.s)
+		if (err != nil) != tt.err {
+			t.Errorf("#%d: unexpected error: %v", i, err)
+			continue
+		}
+		if !reflect.DeepEqual(p, tt.want) {
+			t.Errorf("#%d: got %+v, expected %+v", i, p, tt.want)
+		}
+		if err == nil && p.String() != tt.s {
+			t.Errorf("#%d: got %q, expected %q", i, p.String(), tt.s)
+		}
+	}
+}
+
+func TestGroupMergesPlatforms(t *testing.T) {
+	rl := &RawLicense{Path: "a/LICENSE"}
+	grouped, err := groupPackagesByLicense([]GoPackage{
+		{PackageName: "a/b", Platforms: []string{"linux/amd64"}, RawLicenses: []*RawLicense{rl}},
+		{PackageName: "a/c", Platforms: []string{"windows/amd64"}, RawLicenses: []*RawLicense{rl}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(grouped) != 1 {
+		t.Fatalf("got %d packages, expected 1", len(grouped))
+	}
+	wanted := []string{"linux/amd64", "windows/amd64"}
+	if gp := grouped[0]; gp.PackageName != "a" || !reflect.DeepEqual(gp.Platforms, wanted) {
+		t.Fatalf("got %s %v, expected a %v", gp.PackageName, gp.Platforms, wanted)
+	}
+}
@@ -0,0 +1,83 @@
+package bom
+
+import (
+	"runtime"
+	"sync"
+)
+
+// forEach calls f with every index from 0 to n-1, on up to jobs goroutines.
+// It returns the error of the lowest failing index, indices above it are
+// skipped once it fails.
+func forEach(n, jobs int, f func(i int) error) error {
+	if jobs <= 0 {
+		jobs = runtime.GOMAXPROCS(0)
+	}
+	var (
+		mu     sync.Mutex
+		failed = n
+		err    error
+		wg     sync.WaitGroup
+	)
+	indices := make(chan int)
+	for j := 0; j < jobs; j++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indices {
+				mu.Lock()
+				skip := i > failed
+				mu.Unlock()
+				if skip {
+					continue
+				}
+				if e := f(i); e != nil {
+					mu.Lock()
+					if i < failed {
+						failed, err = i, e
+					}
+					mu.Unlock()
+				}
+			}
+		}()
+	}
+	for i := 0; i < n; i++ {
+		indices <- i
+	}
+	close(indices)
+	wg.Wait()
+	return err
+}
+
+// onceCache memoizes values by key. Concurrent lookups of a key wait for a
+// single computation of its value.
+type onceCache struct {
+	mu      sync.Mutex
+	entries map[string]*onceEntry
+}
+
+type onceEntry struct {
+	done  chan struct{}
+	value interface{}
+	err   error
+}
+
+// get returns the value of key, computed by compute on first lookup.
+func (c *onceCache) get(key string, compute func() (interface{}, error)) (interface{}, error) {
+	c.mu.Lock()
+	if c.entries == nil {
+		c.entries = map[string]*onceEntry{}
+	}
+	e, ok := c.entries[key]
+	if !ok {
+		e = &onceEntry{done: make(chan struct{})}
+		c.entries[key] = e
+	}
+	c.mu.Unlock()
+	if ok {
+		<-e.done
+		return e.value, e.err
+	}
+	e.value, e.err = compute()
+	close(e.done)
+	return e.value, e.err
+}
@@ -0,0 +1,85 @@
+package bom
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"reflect"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestForEach(t *testing.T) {
+	for _, jobs := range []int{0, 1, 4, 100} {
+		var mu sync.Mutex
+		seen := map[int]bool{}
+		err := forEach(50, jobs, func(i int) error {
+			mu.Lock()
+			seen[i] = true
+			mu.Unlock()
+			return nil
+		})
+		if err != nil || len(seen) != 50 {
+			t.Fatalf("jobs %d: got %d indices and %v", jobs, len(seen), err)
+		}
+
+		err = forEach(50, jobs, func(i int) error {
+			if i == 17 || i == 31 {
+				return fmt.Errorf("failed %d", i)
+			}
+			return nil
+		})
+		if err == nil || err.Error() != "failed 17" {
+			t.Fatalf("jobs %d: unexpected error %v", jobs, err)
+		}
+	}
+}
+
+func TestOnceCache(t *testing.T) {
+	c := &onceCache{}
+	calls := int32(0)
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			key := fmt.Sprint(i % 2)
+			v, err := c.get(key, func() (interface{}, error) {
+				atomic.AddInt32(&calls, 1)
+				return "value " + key, nil
+			})
+			if err != nil || v != "value "+key {
+				t.Errorf("unexpected value for %s: %v, %v", key, v, err)
+			}
+		}(i)
+	}
+	wg.Wait()
+	if calls != 2 {
+		t.Fatalf("expected 2 computations, got %d", calls)
+	}
+}
+
+func TestConcurrentScan(t *testing.T) {
+	gopath, err := filepath.Abs("testdata")
+	if err != nil {
+		t.Fatal(err)
+	}
+	scan := func(jobs int) *Report {
+		report, err := Scan(context.Background(), Options{
+			Packages: []string{"colors/cmd/paint", "colors/dual", "colors/either"},
+			GOPATH:   gopath,
+			Jobs:     jobs,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return report
+	}
+	expected := scan(1)
+	for i := 0; i < 5; i++ {
+		if got := scan(8); !reflect.DeepEqual(expected, got) {
+			t.Fatalf("reports differ:\ngot      %+v\nexpected %+v", got, expected)
+		}
+	}
+}
@@ -0,0 +1,284 @@
+package bom
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+const (
+	// SourceReadme designates licenses detected in a README license section
+	// instead of a license file.
+	SourceReadme = "readme"
+	// readmeTrust weights the score of licenses detected in README files,
+	// which often mention a license without its text.
+	readmeTrust = 0.9
+	// readmeMinScore is the score below which a README license section is
+	// not taken for a license text. It may still name a license, which is
+	// then reported with this score.
+	readmeMinScore = 0.5
+)
+
+var (
+	reATXHeading     = regexp.MustCompile(`^(#{1,6})\s+(.*?)[\s#]*$`)
+	reUnderline      = regexp.MustCompile(`^(?:={3,}|-{3,}|~{3,}|\^{3,}|\*{3,}|\+{3,})\s*$`)
+	reLicenseHeading = regexp.MustCompile(
+		`(?i)^\W*(?:(?:copyright|legal)\W+(?:and\W+)?)?licen[sc](?:e|es|ing)\b.{0,30}$`)
+	// reLicenseLine matches a line introducing the license of a README
+	// without headings, like "License: MIT".
+	reLicenseLine = regexp.MustCompile(`(?i)^licen[sc](?:e|ing)\s*(?::\s*(.*))?$`)
+	reFence       = regexp.MustCompile("^\\s*(?:```|~~~)")
+	// reLicenseID matches the words of a license section which may be SPDX
+	// identifiers, like "Apache-2.0".
+	reLicenseID = regexp.MustCompile(`[\w.+-]+`)
+	// reNameWord matches the words of license names, keeping versions like
+	// "2.0" whole.
+	reNameWord = regexp.MustCompile(`[a-z0-9]+(?:\.[0-9]+)*`)
+)
+
+// readmeHeading is a section heading of a README file.
+type readmeHeading struct {
+	// Line is the index of the heading title line, Start the one of the
+	// first line after the heading.
+	Line  int
+	Start int
+	Level int
+	Title string
+}
+
+// readmeHeadings returns the headings of README file lines: Markdown "#"
+// headings, and Markdown or reStructuredText titles followed by an
+// underline. Underline characters define levels in order of appearance.
+// Lines of Markdown code blocks are ignored.
+func readmeHeadings(lines []string) []readmeHeading {
+	headings := []readmeHeading{}
+	underlines := map[byte]int{}
+	fenced := false
+	for i := 0; i < len(lines); i++ {
+		line := strings.TrimRight(lines[i], " \t\r")
+		if reFence.MatchString(line) {
+			fenced = !fenced
+			continue
+		}
+		if fenced {
+			continue
+		}
+		if m := reATXHeading.FindStringSubmatch(line); m != nil {
+			headings = append(headings, readmeHeading{
+				Line:  i,
+				Start: i + 1,
+				Level: len(m[1]),
+				Title: m[2],
+			})
+			continue
+		}
+		title := strings.TrimSpace(line)
+		if title == "" || i+1 >= len(lines) {
+			continue
+		}
+		underline := strings.TrimSpace(lines[i+1])
+		if !reUnderline.MatchString(underline) {
+			continue
+		}
+		level, ok := underlines[underline[0]]
+		if !ok {
+			level = len(underlines) + 1
+			underlines[underline[0]] = level
+		}
+		headings = append(headings, readmeHeading{
+			Line:  i,
+			Start: i + 2,
+			Level: level,
+			Title: title,
+		})
+		i++
+	}
+	return headings
+}
+
+// readmeLicenseSection returns the text of the first license section of
+// README data, up to the next heading of the same or a higher level. In a
+// README without headings, the section starts at a line introducing the
+// license, like "License: MIT", and runs to the end of the file.
+func readmeLicenseSection(data []byte) []byte {
+	lines := strings.Split(string(data), "\n")
+	headings := readmeHeadings(lines)
+	for i, h := range headings {
+		if !reLicenseHeading.MatchString(h.Title) {
+			continue
+		}
+		end := len(lines)
+		for _, next := range headings[i+1:] {
+			if next.Level <= h.Level {
+				end = next.Line
+				break
+			}
+		}
+		return sectionText(lines[h.Start:end])
+	}
+	if len(headings) > 0 {
+		return nil
+	}
+	for i, line := range lines {
+		if m := reLicenseLine.FindStringSubmatch(strings.TrimSpace(line)); m != nil {
+			return sectionText(append([]string{m[1]}, lines[i+1:]...))
+		}
+	}
+	return nil
+}
+
+// sectionText joins section lines, or returns nil if they have no words.
+func sectionText(lines []string) []byte {
+	text := strings.Join(lines, "\n")
+	if !reWords.MatchString(text) {
+		return nil
+	}
+	return []byte(text)
+}
+
+// findReadmeLicense looks for a license section in the README files of
+// supplied package directory, and up to parent directories like
+// findLicenses, with directory listings cached in dirs. It returns the
+// README path and the section text, or an empty path if none was found.
+func findReadmeLicense(info *PkgInfo, dirs *onceCache) (string, []byte, error) {
+	for _, dir := range licenseDirs(info) {
+		fis, err := readDir(dirs, dir)
+		if err != nil {
+			return "", nil, err
+		}
+		for _, fi := range fis {
+			if !fi.Mode().IsRegular() || !reReadme.MatchString(fi.Name()) {
+				continue
+			}
+			path := filepath.Join(dir, fi.Name())
+			data, err := ioutil.ReadFile(path)
+			if err != nil {
+				return "", nil, err
+			}
+			if section := readmeLicenseSection(data); section != nil {
+				return path, section, nil
+			}
+		}
+	}
+	return "", nil, nil
+}
+
+// licenseName returns the normalized words of a license name, surrounded
+// with spaces. "The" and "version" are dropped, so "the Apache License,
+// Version 2.0" contains "Apache License 2.0".
+func licenseName(name string) string {
+	words := []string{""}
+	for _, w := range reNameWord.FindAllString(strings.ToLower(name), -1) {
+		if w == "the" || w == "version" {
+			continue
+		}
+		if len(w) > 1 && w[0] == 'v' && w[1] >= '0' && w[1] <= '9' {
+			w = w[1:]
+		}
+		words = append(words, w)
+	}
+	return strings.Join(append(words, ""), " ")
+}
+
+// licenseNextWords and licensePrevWords are the words which make the name or
+// SPDX identifier next to them designate a license, when they follow it, as
+// in "MIT License", or precede it, as in "licensed under the MIT".
+var (
+	licenseNextWords = map[string]bool{
+		"license": true, "licence": true, "licensed": true, "licenses": true,
+	}
+	licensePrevWords = map[string]bool{
+		"under": true, "spdx-license-identifier": true,
+	}
+)
+
+// namesLicense returns true if the words surrounding a license name or
+// identifier, "the" excepted, make it designate a license.
+func namesLicense(prev, next string) bool {
+	return licensePrevWords[strings.ToLower(prev)] || licenseNextWords[strings.ToLower(next)]
+}
+
+// namedTemplate returns the template of the license named in a README
+// license section, or nil. SPDX identifiers are looked up first, then
+// template titles and nicknames, longest first. Names like "JSON" or "Vim"
+// also appear in prose, so a name only designates a license when it is
+// surrounded with license words, when it is alone on the first line of the
+// section, or when it contains "license" itself.
+func namedTemplate(section []byte, templates []*Template) *Template {
+	text := strings.TrimSpace(string(section))
+	first := text
+	if i := strings.IndexByte(text, '\n'); i >= 0 {
+		first = text[:i]
+	}
+	words := []string{}
+	for _, w := range reLicenseID.FindAllString(text, -1) {
+		if w = strings.TrimRight(w, ".-"); w != "" && !strings.EqualFold(w, "the") {
+			words = append(words, w)
+		}
+	}
+	lone := len(reLicenseID.FindAllString(first, -1)) == 1
+	for i, w := range words {
+		prev, next := "", ""
+		if i > 0 {
+			prev = words[i-1]
+		}
+		if i+1 < len(words) {
+			next = words[i+1]
+		}
+		if !namesLicense(prev, next) && !(i == 0 && lone) {
+			continue
+		}
+		id := spdxFamily(currentSPDXID(w))
+		for _, t := range templates {
+			if t.SPDXID != "" && id == t.SPDXID {
+				return t
+			}
+		}
+	}
+	type templateName struct {
+		Name     string
+		Template *Template
+	}
+	names := []templateName{}
+	for _, t := range templates {
+		names = append(names, templateName{licenseName(t.Title), t})
+		if t.Nickname != "" {
+			names = append(names, templateName{licenseName(t.Nickname), t})
+		}
+	}
+	sort.SliceStable(names, func(i, j int) bool {
+		return len(names[i].Name) > len(names[j].Name)
+	})
+	text = licenseName(text)
+	first = licenseName(first)
+	for _, n := range names {
+		if strings.TrimSpace(n.Name) == "" {
+			continue
+		}
+		if n.Name == first || strings.Contains(n.Name, "licen") && strings.Contains(text, n.Name) {
+			return n.Template
+		}
+		for i := 0; ; {
+			j := strings.Index(text[i:], n.Name)
+			if j < 0 {
+				break
+			}
+			before := strings.Fields(text[:i+j])
+			after := strings.Fields(text[i+j+len(n.Name):])
+			prev, next := "", ""
+			if len(before) > 0 {
+				prev = before[len(before)-1]
+			}
+			if len(after) > 0 {
+				next = after[0]
+			}
+			if namesLicense(prev, next) {
+				return n.Template
+			}
+			i += j + 1
+		}
+	}
+	return nil
+}
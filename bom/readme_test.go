@@ -0,0 +1,116 @@
+package bom
+
+import (
+	"context"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestReadmeLicenseSection(t *testing.T) {
+	tests := []struct {
+		readme  string
+		section string
+	}{
+		{"# Foo\n\nText.\n\n## License\n\nMIT\n\n## Usage\n\nfoo()\n", "MIT"},
+		{"# Foo\n\n## Licensing\n\nMIT\n\n### Details\n\nBelow.\n# Other\n", "MIT\n\n### Details\n\nBelow."},
+		{"# Foo\n\n```\n# License\n```\n\nNothing else.\n", ""},
+		{"Foo\n===\n\nText.\n\nLicense\n-------\n\nBSD\n\nUsage\n-----\n", "BSD"},
+		{"Foo\n###\n\nLicense & Copyright\n***\n\nApache\n", "Apache"},
+		{"Foo is great.\n\nLicense: MIT\nSee LICENSE.\n", "MIT\nSee LICENSE."},
+		{"Foo is great.\n\nLICENSE\n\nISC\n", "ISC"},
+		{"# Foo\n\nLicensed under MIT.\n", ""},
+		{"# Foo\n\n## License\n\n## Usage\n", ""},
+	}
+	for i, test := range tests {
+		section := strings.TrimSpace(string(readmeLicenseSection([]byte(test.readme))))
+		if section != test.section {
+			t.Errorf("#%d: got %q, expected %q", i, section, test.section)
+		}
+	}
+}
+
+func TestReadmeLicense(t *testing.T) {
+	gopath, err := filepath.Abs("testdata")
+	if err != nil {
+		t.Fatal(err)
+	}
+	report, err := Scan(context.Background(), Options{
+		Packages: []string{"colors/readme"},
+		GOPATH:   gopath,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(report.Errors) != 0 || len(report.Projects) != 1 {
+		t.Fatalf("unexpected report: %+v", report)
+	}
+	pl := report.Projects[0]
+	if len(pl.Licenses) != 1 {
+		t.Fatalf("unexpected licenses: %+v", pl.Licenses)
+	}
+	l := pl.Licenses[0]
+	if l.SPDXID != "MIT" || l.Source != SourceReadme || l.Confidence >= readmeTrust ||
+		l.Confidence < 0.8 || pl.Expression != "MIT" {
+		t.Fatalf("unexpected license: %+v", l)
+	}
+	if len(pl.Copyrights) != 1 || pl.Copyrights[0].Holders != "Patrick Mézard" {
+		t.Fatalf("unexpected copyrights: %+v", pl.Copyrights)
+	}
+}
+
+func TestReadmeNamedLicense(t *testing.T) {
+	templates, err := loadTemplates()
+	if err != nil {
+		t.Fatal(err)
+	}
+	index := newTemplateIndex(templates)
+	tests := []struct {
+		section string
+		spdxID  string
+	}{
+		{"MIT", "MIT"},
+		{"Licensed under the Apache License, Version 2.0.", "Apache-2.0"},
+		{"Released under the GNU GPL v3.0, see COPYING.", "GPL-3.0"},
+		{"This project is distributed under the terms of the BSD-3-Clause license.", "BSD-3-Clause"},
+		{"SPDX-License-Identifier: ISC", "ISC"},
+		{"GPL-2.0", "GPL-2.0"},
+		{"Mozilla Public License 1.1", "MPL-1.1"},
+		{"See the LICENSE file.", ""},
+		{"All rights reserved.", ""},
+		{"This tool reads JSON and Ruby files, and runs in Vim.", ""},
+		{"Copyright 2018 The X11 and ICU contributors.", ""},
+		{"Embeds ICU data.\n\nSee the LICENSE file.", ""},
+	}
+	for i, test := range tests {
+		rl := matchReadmeLicense("README.md", []byte(test.section), index)
+		if test.spdxID == "" {
+			if rl.Template != nil {
+				t.Errorf("#%d: unexpected template %q at %v", i, rl.Template.Title, rl.Score)
+			}
+			continue
+		}
+		if rl.Template == nil || rl.Template.SPDXID != test.spdxID ||
+			rl.Score != readmeMinScore || rl.Source != SourceReadme {
+			t.Errorf("#%d: got %+v, expected %s", i, rl, test.spdxID)
+		}
+	}
+}
+
+func TestReadmeNoLicense(t *testing.T) {
+	gopath, err := filepath.Abs("testdata")
+	if err != nil {
+		t.Fatal(err)
+	}
+	report, err := Scan(context.Background(), Options{
+		Packages: []string{"colors/unnamed"},
+		GOPATH:   gopath,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(report.Projects) != 0 || len(report.Errors) != 1 ||
+		report.Errors[0].Error != "No license detected" {
+		t.Fatalf("unexpected report: %+v", report)
+	}
+}
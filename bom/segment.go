@@ -0,0 +1,159 @@
+package bom
+
+import (
+	"bytes"
+	"strings"
+	"unicode"
+)
+
+const (
+	// minSegmentWords is the minimum number of words of a license text
+	// looked for next to another one in the same file.
+	minSegmentWords = 20
+	// minSegmentScore is the minimum score of such a license text.
+	minSegmentScore = 0.8
+	// minExtentBlock is the minimum number of consecutive words shared with
+	// a template for a license text to be considered as part of it.
+	minExtentBlock = 5
+)
+
+// licenseToken is a license word and its byte offset in the license file.
+type licenseToken struct {
+	Text   string
+	Offset int
+}
+
+// licenseTokens returns the words of license data, like licenseWords, with
+// their offsets.
+func licenseTokens(data []byte) []licenseToken {
+	copyrights := reCopyright.FindAllIndex(data, -1)
+	tokens := []licenseToken{}
+	for _, loc := range reWords.FindAllIndex(data, -1) {
+		for len(copyrights) > 0 && copyrights[0][1] <= loc[0] {
+			copyrights = copyrights[1:]
+		}
+		if len(copyrights) > 0 && copyrights[0][0] <= loc[0] {
+			continue
+		}
+		tokens = append(tokens, licenseToken{
+			Text:   strings.ToLower(string(data[loc[0]:loc[1]])),
+			Offset: loc[0],
+		})
+	}
+	return tokens
+}
+
+// Segment is a license text matched in a part of a license file.
+type Segment struct {
+	MatchResult
+	// Start and End are the byte offsets of the text in the file.
+	Start int
+	End   int
+	// StartLine and EndLine are the first and last lines of the text,
+	// starting at 1.
+	StartLine int
+	EndLine   int
+}
+
+// templateExtent returns the range of words matching template t, as
+// delimited by the first and last blocks of words shared with it.
+func templateExtent(t *Template, words []string) (int, int) {
+	lo, hi := 0, 0
+	m := newWordMatcher(t.sequence, words)
+	for _, block := range m.matchingBlocks() {
+		if block.Size < minExtentBlock {
+			continue
+		}
+		if hi == 0 {
+			lo = block.B
+		}
+		hi = block.B + block.Size
+	}
+	return lo, hi
+}
+
+type wordSegment struct {
+	Lo, Hi int
+	Match  MatchResult
+}
+
+// findSegments matches words[lo:hi] against indexed templates, then looks
+// for other license texts before and after the text of the best template.
+func findSegments(words []string, lo, hi int, ix *templateIndex) []wordSegment {
+	m := ix.match(words[lo:hi])
+	if m.Template == nil {
+		return nil
+	}
+	elo, ehi := templateExtent(m.Template, words[lo:hi])
+	if elo == ehi {
+		return nil
+	}
+	elo, ehi = elo+lo, ehi+lo
+	if elo > lo || ehi < hi {
+		m = ix.match(words[elo:ehi])
+	}
+	segments := []wordSegment{}
+	others := func(lo, hi int) {
+		if hi-lo < minSegmentWords {
+			return
+		}
+		for _, s := range findSegments(words, lo, hi, ix) {
+			if s.Match.Score >= minSegmentScore {
+				segments = append(segments, s)
+			}
+		}
+	}
+	others(lo, elo)
+	segments = append(segments, wordSegment{Lo: elo, Hi: ehi, Match: m})
+	others(ehi, hi)
+	return segments
+}
+
+// matchSegments returns the license texts found in supplied license data,
+// in order, when there are more than one.
+func matchSegments(data []byte, ix *templateIndex) []Segment {
+	tokens := licenseTokens(data)
+	words := make([]string, len(tokens))
+	for i, t := range tokens {
+		words[i] = t.Text
+	}
+	found := findSegments(words, 0, len(words), ix)
+	if len(found) < 2 {
+		return nil
+	}
+	segments := []Segment{}
+	for _, s := range found {
+		// Segments span whole lines, including the copyright ones before
+		// their first word.
+		start := 0
+		if s.Lo > 0 {
+			prev := tokens[s.Lo-1]
+			start = lineEnd(data, prev.Offset+len(prev.Text), len(data))
+		}
+		start += len(data[start:]) - len(bytes.TrimLeftFunc(data[start:], unicode.IsSpace))
+		last := tokens[s.Hi-1]
+		end := len(data)
+		if s.Hi < len(tokens) {
+			end = tokens[s.Hi].Offset
+		}
+		end = lineEnd(data, last.Offset+len(last.Text), end)
+		segment := Segment{
+			MatchResult: withChanges(s.Match, words[s.Lo:s.Hi]),
+			Start:       start,
+			End:         len(bytes.TrimRightFunc(data[:end], unicode.IsSpace)),
+		}
+		segment.StartLine = bytes.Count(data[:segment.Start], []byte("\n")) + 1
+		segment.EndLine = bytes.Count(data[:segment.End], []byte("\n")) + 1
+		segments = append(segments, segment)
+	}
+	return segments
+}
+
+// lineEnd returns the offset of the end of the line containing offset, or
+// limit if it comes first.
+func lineEnd(data []byte, offset, limit int) int {
+	if i := bytes.IndexByte(data[offset:limit], '\n'); i >= 0 {
+		return offset + i
+	}
+	return limit
+}
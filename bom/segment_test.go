@@ -0,0 +1,72 @@
+package bom
+
+import (
+	"context"
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func readTestLicense(t *testing.T, path string) string {
+	data, err := ioutil.ReadFile(filepath.Join("testdata", path))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(data)
+}
+
+func TestMatchSegments(t *testing.T) {
+	templates, err := loadTemplates()
+	if err != nil {
+		t.Fatal(err)
+	}
+	apacheLines := strings.Count(readTestLicense(t, "src/colors/blue/LICENSE"), "\n")
+	data := readTestLicense(t, "src/colors/dual/LICENSE")
+	segments := matchSegments([]byte(data), newTemplateIndex(templates))
+	if len(segments) != 2 {
+		t.Fatalf("expected 2 segments, got %+v", segments)
+	}
+	first, second := segments[0], segments[1]
+	if first.Template.SPDXID != "Apache-2.0" || first.Score != 1 ||
+		first.StartLine != 1 || first.EndLine != apacheLines {
+		t.Errorf("unexpected first segment: %s %f lines %d-%d",
+			first.Template.SPDXID, first.Score, first.StartLine, first.EndLine)
+	}
+	if second.Template.SPDXID != "MIT" || second.Score != 1 ||
+		!strings.HasPrefix(data[second.Start:], "Copyright (c) 2015") ||
+		second.End != len(strings.TrimRight(data, "\n")) {
+		t.Errorf("unexpected second segment: %s %f %q", second.Template.SPDXID,
+			second.Score, data[second.Start:second.End])
+	}
+
+	// Single licenses, even with a preamble, have no segments
+	data = "The colors package is licensed as follows:\n\n" +
+		readTestLicense(t, "src/colors/red/LICENSE")
+	if segments := matchSegments([]byte(data), newTemplateIndex(templates)); segments != nil {
+		t.Fatalf("unexpected segments: %+v", segments)
+	}
+}
+
+func TestSegmentedLicense(t *testing.T) {
+	gopath, err := filepath.Abs("testdata")
+	if err != nil {
+		t.Fatal(err)
+	}
+	report, err := Scan(context.Background(), Options{
+		Packages: []string{"colors/dual"},
+		GOPATH:   gopath,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	wanted := []License{
+		{Type: "Apache License 2.0", SPDXID: "Apache-2.0", Confidence: 1, Lines: "1-201"},
+		{Type: "MIT License", SPDXID: "MIT", Confidence: 1, Lines: "207-225"},
+	}
+	if len(report.Projects) != 1 ||
+		!reflect.DeepEqual(report.Projects[0].Licenses, wanted) {
+		t.Fatalf("unexpected projects: %+v", report.Projects)
+	}
+}
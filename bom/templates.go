@@ -0,0 +1,80 @@
+package bom
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// loadTemplateDir parses the templates of the .txt files of supplied
+// directory, sorted by file name.
+func loadTemplateDir(dir string) ([]*Template, error) {
+	fis, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(fis, func(i, j int) bool { return fis[i].Name() < fis[j].Name() })
+	templates := []*Template{}
+	for _, fi := range fis {
+		if !fi.Mode().IsRegular() || filepath.Ext(fi.Name()) != ".txt" {
+			continue
+		}
+		path := filepath.Join(dir, fi.Name())
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		t, err := parseTemplate(string(data))
+		if err != nil {
+			return nil, fmt.Errorf("could not parse %s: %s", path, err)
+		}
+		if t.Title == "" {
+			return nil, fmt.Errorf("template %s has no title", path)
+		}
+		templates = append(templates, t)
+	}
+	return templates, nil
+}
+
+// mergeTemplates returns the base templates, where the ones with the title or
+// SPDX identifier of a custom template are replaced with it, followed by the
+// other custom templates. Custom templates without conditions, permissions
+// and limitations inherit the ones of the template they replace.
+func mergeTemplates(base, custom []*Template) []*Template {
+	same := func(a, b *Template) bool {
+		return strings.EqualFold(a.Title, b.Title) ||
+			a.SPDXID != "" && strings.EqualFold(spdxFamily(a.SPDXID), spdxFamily(b.SPDXID))
+	}
+	merged := []*Template{}
+	for _, t := range base {
+		for _, c := range custom {
+			if same(t, c) {
+				if len(c.Required)+len(c.Permitted)+len(c.Forbidden) == 0 {
+					c.Required, c.Permitted, c.Forbidden = t.Required, t.Permitted, t.Forbidden
+				}
+				t = nil
+				break
+			}
+		}
+		if t != nil {
+			merged = append(merged, t)
+		}
+	}
+	return append(merged, custom...)
+}
+
+// templates returns the built-in templates merged with the ones of
+// TemplateDir, if set.
+func (opts *Options) templates() ([]*Template, error) {
+	templates, err := loadTemplates()
+	if err != nil || opts.TemplateDir == "" {
+		return templates, err
+	}
+	custom, err := loadTemplateDir(opts.TemplateDir)
+	if err != nil {
+		return nil, fmt.Errorf("could not load templates: %s", err)
+	}
+	return mergeTemplates(templates, custom), nil
+}
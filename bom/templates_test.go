@@ -0,0 +1,84 @@
+package bom
+
+import (
+	"context"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestMergeTemplates(t *testing.T) {
+	base, err := loadTemplates()
+	if err != nil {
+		t.Fatal(err)
+	}
+	custom, err := loadTemplateDir(filepath.Join("testdata", "templates"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(custom) != 2 {
+		t.Fatalf("expected 2 custom templates, got %d", len(custom))
+	}
+	merged := mergeTemplates(base, custom)
+	if len(merged) != len(base)+1 {
+		t.Fatalf("expected %d templates, got %d", len(base)+1, len(merged))
+	}
+	mits := 0
+	for _, tmpl := range merged {
+		if tmpl.SPDXID == "MIT" {
+			mits++
+			if tmpl.Metadata["category"] != "permissive" {
+				t.Fatalf("MIT template was not replaced: %+v", tmpl.Metadata)
+			}
+		}
+	}
+	if mits != 1 {
+		t.Fatalf("expected one MIT template, got %d", mits)
+	}
+	for _, tmpl := range base {
+		if tmpl.Metadata != nil {
+			t.Fatalf("built-in template %q was modified", tmpl.Title)
+		}
+	}
+}
+
+func TestTemplateDir(t *testing.T) {
+	gopath, err := filepath.Abs("testdata")
+	if err != nil {
+		t.Fatal(err)
+	}
+	report, err := Scan(context.Background(), Options{
+		Packages:    []string{"colors/acme", "colors/red"},
+		GOPATH:      gopath,
+		TemplateDir: filepath.Join(gopath, "templates"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	wl := [][]License{
+		{{Type: "Acme Software License Agreement", Confidence: 1,
+			Metadata: map[string]string{
+				"category": "proprietary",
+				"contact":  "legal@acme.example",
+			}}},
+		{{Type: "MIT License", SPDXID: "MIT", Confidence: 1,
+			Metadata: map[string]string{"category": "permissive"}}},
+	}
+	if len(report.Projects) != len(wl) {
+		t.Fatalf("unexpected projects: %+v", report.Projects)
+	}
+	for i, pl := range report.Projects {
+		if !reflect.DeepEqual(wl[i], pl.Licenses) {
+			t.Errorf("%s:\ngot      %+v,\nexpected %+v", pl.Project, pl.Licenses, wl[i])
+		}
+	}
+
+	_, err = Scan(context.Background(), Options{
+		Packages:    []string{"colors/red"},
+		GOPATH:      gopath,
+		TemplateDir: filepath.Join(gopath, "missing"),
+	})
+	if err == nil {
+		t.Fatal("error expected for a missing template directory")
+	}
+}
@@ -0,0 +1,10 @@
+package main
+
+import (
+	"example.com/lib/sub"
+	"fmt"
+)
+
+func main() {
+	fmt.Println(sub.Sub())
+}
@@ -0,0 +1,13 @@
+package main
+
+import (
+	"testing"
+
+	"example.com/lib/testutil"
+)
+
+func TestMain(t *testing.T) {
+	if !testutil.Check() {
+		t.Fatal("check failed")
+	}
+}
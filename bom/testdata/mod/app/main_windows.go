@@ -0,0 +1,9 @@
+package main
+
+import (
+	"example.com/lib/win"
+)
+
+func init() {
+	win.Win()
+}
@@ -0,0 +1,5 @@
+package testutil
+
+func Check() bool {
+	return true
+}
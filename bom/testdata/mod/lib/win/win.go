@@ -0,0 +1,5 @@
+package win
+
+func Win() string {
+	return "win"
+}
@@ -0,0 +1,5 @@
+package acme
+
+func acme() string {
+	return "acme"
+}
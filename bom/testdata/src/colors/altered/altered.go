@@ -0,0 +1,5 @@
+package altered
+
+func altered() string {
+	return "altered"
+}
@@ -0,0 +1,5 @@
+package dual
+
+func dual() string {
+	return "dual"
+}
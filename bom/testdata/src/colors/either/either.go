@@ -0,0 +1,5 @@
+package either
+
+func either() string {
+	return "either"
+}
@@ -0,0 +1,5 @@
+package vendored
+
+func vendored() string {
+	return "vendored"
+}
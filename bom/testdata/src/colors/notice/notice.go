@@ -0,0 +1,7 @@
+package notice
+
+import "colors/notice/sub"
+
+func notice() string {
+	return sub.Sub()
+}
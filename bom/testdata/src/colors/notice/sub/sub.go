@@ -0,0 +1,5 @@
+package sub
+
+func Sub() string {
+	return "sub"
+}
@@ -0,0 +1,5 @@
+package readme
+
+func readme() string {
+	return "readme"
+}
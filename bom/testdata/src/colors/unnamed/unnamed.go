@@ -0,0 +1,5 @@
+package unnamed
+
+func unnamed() string {
+	return "unnamed"
+}
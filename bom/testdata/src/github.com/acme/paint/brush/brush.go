@@ -0,0 +1,5 @@
+package brush
+
+func brush() string {
+	return "brush"
+}
@@ -0,0 +1,9 @@
+package b
+
+import (
+	"example.com/a"
+)
+
+func B() string {
+	return a.A()
+}
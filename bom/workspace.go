@@ -0,0 +1,262 @@
+package bom
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// listModules returns the main modules of the go command run with supplied
+// options: the current module, or every module of the current workspace.
+func listModules(ctx context.Context, opts Options) ([]*PkgModule, error) {
+	cmd := opts.goCommand(ctx, "list", "-m", "-json")
+	out, err := cmd.Output()
+	if err != nil {
+		if ee, ok := err.(*exec.ExitError); ok {
+			return nil, fmt.Errorf("'go list -m -json' failed with:\n%s", ee.Stderr)
+		}
+		return nil, err
+	}
+	mods := []*PkgModule{}
+	decoder := json.NewDecoder(bytes.NewReader(out))
+	for {
+		m := &PkgModule{}
+		err := decoder.Decode(m)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("could not decode 'go list -m -json' output: %s", err)
+		}
+		mods = append(mods, m)
+	}
+	return mods, nil
+}
+
+// isDirPattern returns true if supplied package expression matches all
+// packages below a filesystem directory, like "./...".
+func isDirPattern(pattern string) bool {
+	if !strings.HasSuffix(pattern, "/...") && pattern != "..." {
+		return false
+	}
+	return pattern == "./..." || strings.HasPrefix(pattern, "./") ||
+		strings.HasPrefix(pattern, "../") || filepath.IsAbs(pattern)
+}
+
+// expandWorkspacePatterns returns supplied options package expressions where
+// directory patterns like "./..." are expanded to every workspace module found
+// below the directory. go list only matches the packages of the module
+// containing the directory otherwise. Expressions are returned unchanged
+// outside workspace mode.
+func expandWorkspacePatterns(ctx context.Context, opts Options) ([]string, error) {
+	hasDirPattern := false
+	for _, p := range opts.Packages {
+		hasDirPattern = hasDirPattern || isDirPattern(p)
+	}
+	if !hasDirPattern {
+		return opts.Packages, nil
+	}
+	out, err := opts.goCommand(ctx, "env", "GOWORK").CombinedOutput()
+	if err != nil {
+		return nil, fmt.Errorf("'go env GOWORK' failed with:\n%s", string(out))
+	}
+	if gowork := strings.TrimSpace(string(out)); gowork == "" || gowork == "off" {
+		return opts.Packages, nil
+	}
+	mods, err := listModules(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	// Module directories are reported with symbolic links resolved.
+	base, err := filepath.Abs(opts.Dir)
+	if err == nil {
+		base, err = filepath.EvalSymlinks(base)
+	}
+	if err != nil {
+		return nil, err
+	}
+	pkgs := []string{}
+	for _, p := range opts.Packages {
+		if !isDirPattern(p) {
+			pkgs = append(pkgs, p)
+			continue
+		}
+		dir := filepath.FromSlash(strings.TrimSuffix(p, "..."))
+		if !filepath.IsAbs(dir) {
+			dir = filepath.Join(base, dir)
+		} else if resolved, err := filepath.EvalSymlinks(dir); err == nil {
+			dir = resolved
+		}
+		expanded := []string{}
+		for _, m := range mods {
+			if m.Dir == "" || !isSubdir(dir, m.Dir) {
+				continue
+			}
+			rel, err := filepath.Rel(base, m.Dir)
+			if err != nil {
+				return nil, err
+			}
+			expanded = append(expanded, "./"+filepath.ToSlash(filepath.Join(rel, "...")))
+		}
+		if len(expanded) == 0 {
+			// Let go list report the pattern does not match anything.
+			expanded = append(expanded, p)
+		}
+		pkgs = append(pkgs, expanded...)
+	}
+	return pkgs, nil
+}
+
+// isSubdir returns true if dir is root or one of its subdirectories.
+func isSubdir(root, dir string) bool {
+	rel, err := filepath.Rel(root, dir)
+	return err == nil && rel != ".." &&
+		!strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
+
+// findModules returns the directories containing a go.mod file below root,
+// sorted. Directories ignored by go commands, like vendor, testdata or those
+// starting with "." or "_", are not searched.
+func findModules(root string) ([]string, error) {
+	dirs := []string{}
+	err := filepath.Walk(root, func(path string, fi os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if fi.IsDir() {
+			name := fi.Name()
+			if path != root && (name == "vendor" || name == "testdata" ||
+				strings.HasPrefix(name, ".") || strings.HasPrefix(name, "_")) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if fi.Name() == "go.mod" {
+			dirs = append(dirs, filepath.Dir(path))
+		}
+		return nil
+	})
+	sort.Strings(dirs)
+	return dirs, err
+}
+
+// scanModules scans every module found below the directory of supplied
+// options in isolation, ignoring any workspace. It returns a report merging
+// the projects of all modules, whose Modules field holds the per-module
+// reports. Projects belonging to one of the scanned modules are marked as
+// main ones.
+func scanModules(ctx context.Context, opts Options) (*Report, error) {
+	root := opts.Dir
+	if root == "" {
+		root = "."
+	}
+	dirs, err := findModules(root)
+	if err != nil {
+		return nil, err
+	}
+	if len(opts.Packages) == 0 {
+		opts.Packages = []string{"./..."}
+	}
+	opts.Recursive = false
+	opts.Env = append(append([]string{}, opts.Env...), "GOWORK=off")
+
+	reports := []*Report{}
+	for _, dir := range dirs {
+		mopts := opts
+		mopts.Dir = dir
+		mods, err := listModules(ctx, mopts)
+		if err != nil {
+			return nil, err
+		}
+		if len(mods) != 1 {
+			return nil, fmt.Errorf("expected one module in %s, got %d", dir, len(mods))
+		}
+		r, err := Scan(ctx, mopts)
+		if err != nil {
+			return nil, fmt.Errorf("could not scan module %s: %s", mods[0].Path, err)
+		}
+		r.Module = mods[0].Path
+		reports = append(reports, r)
+	}
+	modules := []string{}
+	for _, r := range reports {
+		modules = append(modules, r.Module)
+	}
+	for _, r := range reports {
+		markMainProjects(r.Projects, modules)
+		markMainProjects(r.Errors, modules)
+		markMainProjects(r.Excluded, modules)
+		markMainProjects(r.Uncertain, modules)
+	}
+	return mergeReports(reports), nil
+}
+
+// markMainProjects flags projects belonging to one of supplied modules.
+func markMainProjects(pls []ProjectAndLicenses, modules []string) {
+	for i, pl := range pls {
+		for _, m := range modules {
+			if pl.Project == m || strings.HasPrefix(pl.Project, m+"/") {
+				pls[i].Main = true
+				pls[i].Version = ""
+				pls[i].Replace = ""
+				break
+			}
+		}
+	}
+}
+
+// mergeReports returns a report holding the union of supplied reports
+// projects. Projects reported at the same version by several reports are
+// listed once.
+func mergeReports(reports []*Report) *Report {
+	merged := &Report{
+		Modules: reports,
+	}
+	merge := func(dst []ProjectAndLicenses, src []ProjectAndLicenses,
+		index map[string]int) []ProjectAndLicenses {
+		for _, pl := range src {
+			key := pl.Project + "@" + pl.Version
+			if i, ok := index[key]; ok {
+				dst[i].Platforms = mergeStrings(dst[i].Platforms, pl.Platforms)
+				dst[i].Scope = mergeStrings(dst[i].Scope, pl.Scope)
+				dst[i].Why = shortestChain(dst[i].Why, pl.Why)
+				continue
+			}
+			index[key] = len(dst)
+			dst = append(dst, pl)
+		}
+		return dst
+	}
+	projects := map[string]int{}
+	errors := map[string]int{}
+	excluded := map[string]int{}
+	uncertain := map[string]int{}
+	for _, r := range reports {
+		merged.Projects = merge(merged.Projects, r.Projects, projects)
+		merged.Errors = merge(merged.Errors, r.Errors, errors)
+		merged.Excluded = merge(merged.Excluded, r.Excluded, excluded)
+		merged.Uncertain = merge(merged.Uncertain, r.Uncertain, uncertain)
+		merged.Obligations = mergeObligations(merged.Obligations, r.Obligations)
+		merged.Packages = append(merged.Packages, r.Packages...)
+	}
+	sort.Slice(merged.Projects, func(i, j int) bool {
+		return merged.Projects[i].Project < merged.Projects[j].Project
+	})
+	sort.Slice(merged.Errors, func(i, j int) bool {
+		return merged.Errors[i].Project < merged.Errors[j].Project
+	})
+	sort.Slice(merged.Excluded, func(i, j int) bool {
+		return merged.Excluded[i].Project < merged.Excluded[j].Project
+	})
+	sort.Slice(merged.Uncertain, func(i, j int) bool {
+		return merged.Uncertain[i].Project < merged.Uncertain[j].Project
+	})
+	return merged
+}
@@ -0,0 +1,53 @@
+package bom
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+)
+
+func TestWorkspace(t *testing.T) {
+	// ./... matches the packages of every workspace module, not only the one
+	// containing the current directory.
+	report, err := Scan(context.Background(), Options{
+		Packages: []string{"./..."},
+		Dir:      filepath.Join("testdata", "work"),
+		Env:      append(append([]string{}, moduleEnv...), "GOWORK="),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = compareProjects(report.Projects, []ProjectAndLicenses{
+		{Project: "example.com/a", Main: true},
+		{Project: "example.com/b", Main: true},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestRecursive(t *testing.T) {
+	report, err := Scan(context.Background(), Options{
+		Dir:       filepath.Join("testdata", "mod"),
+		Env:       moduleEnv,
+		Recursive: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// example.com/lib/sub is a dependency of example.com/app, but belongs to
+	// a scanned module.
+	err = compareProjects(report.Projects, []ProjectAndLicenses{
+		{Project: "example.com/app", Main: true},
+		{Project: "example.com/lib", Main: true},
+		{Project: "example.com/lib/sub", Main: true},
+		{Project: "example.com/lib/win", Main: true},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(report.Modules) != 2 || report.Modules[0].Module != "example.com/app" ||
+		report.Modules[1].Module != "example.com/lib" {
+		t.Fatalf("unexpected module reports: %+v", report.Modules)
+	}
+}